@@ -17,12 +17,12 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"strconv"
-	"strings"
+	"slices"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
 
 	addonsHandler "github.com/ksctl/ksctl/v2/pkg/handler/addons"
 	"github.com/spf13/cobra"
@@ -43,29 +43,32 @@ ksctl addons --help
 }
 
 func (k *KsctlCommand) EnableAddon() *cobra.Command {
+	bulk := bulkOptions{}
 
 	cmd := &cobra.Command{
 		Use: "enable",
 		Example: `
 ksctl addons enable --help
+ksctl addons enable --selector env=staging
 `,
 		Short: "Use to enable an addon",
-		Long:  "It is used to enable an addon",
+		Long:  "It is used to enable an addon on a cluster, or on many clusters picked with --selector, --all or from the list",
 		Run: func(cmd *cobra.Command, args []string) {
-			m, ok := k.addonClientSetup()
+			clusters, ok := k.addonClusters(bulk)
 			if !ok {
 				os.Exit(1)
 			}
 
-			if k.loadCloudProviderCreds(m.Provider) != nil {
+			if !k.loadBulkCreds(clusters) {
 				os.Exit(1)
 			}
 
+			// the addons and versions of the first cluster are offered for all of them
 			c, err := addonsHandler.NewController(
 				k.Ctx,
 				k.l,
 				&controller.Client{
-					Metadata: *m,
+					Metadata: k.clusterMetadata(clusters[0]),
 				},
 			)
 			if err != nil {
@@ -104,81 +107,116 @@ ksctl addons enable --help
 				os.Exit(1)
 			}
 
-			if cc, err := c.GetAddon(addonSku); err != nil {
-				k.l.Error("Error in getting the addon", "Error", err)
-				os.Exit(1)
-			} else {
-
-				if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterAddonEnable, telemetry.TelemetryMeta{
-					Addons: []telemetry.TelemetryAddon{
-						{
-							Sku:     addonSku,
-							Version: addonVer,
-						},
-					},
-				}); err != nil {
-					k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
-				}
+			if len(clusters) > 1 {
+				results := k.runBulk("addon enable", clusters, bulk.concurrency, func(c provider.ClusterData) (string, error) {
+					if err := k.enableAddon(k.clusterMetadata(c), addonSku, addonVer); err != nil {
+						return "", err
+					}
+					return "enabled " + addonSku + "@" + addonVer, nil
+				})
+				k.reportBulk("addon enable", results)
+				return
+			}
 
-				if _err := cc.Install(addonVer); _err != nil {
-					k.l.Error("Error in enabling the addon", "Error", _err)
-					os.Exit(1)
-				}
+			if err := k.enableAddon(k.clusterMetadata(clusters[0]), addonSku, addonVer); err != nil {
+				k.l.Error("Error in enabling the addon", "Error", err)
+				os.Exit(1)
 			}
 
 			k.l.Success(k.Ctx, "Addon enabled successfully", "sku", addonSku, "version", addonVer)
 		},
 	}
+
+	addBulkFlags(cmd, &bulk)
+
 	return cmd
 }
 
+func (k *KsctlCommand) enableAddon(m controller.Metadata, sku, version string) error {
+	c, err := addonsHandler.NewController(
+		k.Ctx,
+		k.l,
+		&controller.Client{
+			Metadata: m,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create the controller: %w", err)
+	}
+
+	cc, err := c.GetAddon(sku)
+	if err != nil {
+		return fmt.Errorf("failed to get the addon: %w", err)
+	}
+
+	if err := k.sendClusterTelemetry(m, telemetry.EventClusterAddonEnable, telemetry.TelemetryMeta{
+		Addons: []telemetry.TelemetryAddon{
+			{
+				Sku:     sku,
+				Version: version,
+			},
+		},
+	}); err != nil {
+		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
+	}
+
+	return cc.Install(version)
+}
+
 func (k *KsctlCommand) DisableAddon() *cobra.Command {
+	override := false
+	bulk := bulkOptions{}
 
 	cmd := &cobra.Command{
 		Use: "disable",
 		Example: `
 ksctl addons disable --help
+ksctl addons disable --all --concurrency 8
 `,
 		Short: "Use to disable an addon",
-		Long:  "It is used to disable an addon",
+		Long:  "It is used to disable an addon of a cluster, or of many clusters picked with --selector, --all or from the list",
 		Run: func(cmd *cobra.Command, args []string) {
-			m, ok := k.addonClientSetup()
+			clusters, ok := k.addonClusters(bulk)
 			if !ok {
 				os.Exit(1)
 			}
 
-			if k.loadCloudProviderCreds(m.Provider) != nil {
+			if !k.loadBulkCreds(clusters) {
 				os.Exit(1)
 			}
 
-			c, err := addonsHandler.NewController(
-				k.Ctx,
-				k.l,
-				&controller.Client{
-					Metadata: *m,
-				},
-			)
-			if err != nil {
-				k.l.Error("Error in creating the controller", "Error", err)
-				os.Exit(1)
+			// installed is the version of every installed addon of the clusters
+			installed := make(map[string]map[string]string, len(clusters))
+			listErrs := map[string]error{}
+			vals := map[string]string{}
+			for _, cluster := range clusters {
+				key := clusterRecordKey(cluster)
+				addons, err := k.installedAddons(k.clusterMetadata(cluster))
+				if err != nil {
+					if len(clusters) == 1 {
+						k.l.Error("Error in listing the installed addons", "Error", err)
+						os.Exit(1)
+					}
+					k.l.Warn(k.Ctx, "Error in listing the installed addons", "cluster", cluster.Name, "Error", err)
+					listErrs[key] = err
+					continue
+				}
+				installed[key] = addons
+				for name, ver := range addons {
+					if len(clusters) == 1 {
+						vals[fmt.Sprintf("%s@%s", name, ver)] = name
+					} else {
+						vals[name] = name
+					}
+				}
 			}
 
-			addons, err := c.ListInstalledAddons()
-			if err != nil {
-				k.l.Error("Error in listing the installed addons", "Error", err)
+			if len(vals) == 0 {
+				k.l.Error("No addons are installed on the clusters")
 				os.Exit(1)
 			}
 
-			vals := make(map[string]string, len(addons))
-			for _, addon := range addons {
-				ver := "NaN"
-				if addon.Version != "" {
-					ver = addon.Version
-				}
-				vals[fmt.Sprintf("%s@%s", addon.Name, ver)] = addon.Name + "@" + ver
-			}
-
-			_selectedAddon, err := k.menuDriven.DropDown(
+			selectedAddon, err := k.menuDriven.DropDown(
 				"Select the addon to disable",
 				vals,
 			)
@@ -186,74 +224,129 @@ ksctl addons disable --help
 				k.l.Error("Failed to get userinput", "Reason", err)
 				os.Exit(1)
 			}
+			critical := slices.Contains(criticalAddons, selectedAddon)
 
-			selectedAddon := strings.Split(_selectedAddon, "@")[0]
-
-			if cc, err := c.GetAddon(selectedAddon); err != nil {
-				k.l.Error("Error in getting the addon", "Error", err)
-				os.Exit(1)
-			} else {
-
-				if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterAddonDisable, telemetry.TelemetryMeta{
-					Addons: []telemetry.TelemetryAddon{
-						{
-							Sku:     selectedAddon,
-							Version: strings.Split(_selectedAddon, "@")[1],
-						},
-					},
-				}); err != nil {
-					k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
-				}
-
-				if _err := cc.Uninstall(); _err != nil {
-					k.l.Error("Error in disabling the addon", "Error", _err)
+			if len(clusters) > 1 {
+				k.showTargetClusters(clusters)
+				if !k.confirmClusterNames(clusters, "disabling of the "+selectedAddon+" addon") {
 					os.Exit(1)
 				}
+
+				results := k.runBulk("addon disable", clusters, bulk.concurrency, func(c provider.ClusterData) (string, error) {
+					key := clusterRecordKey(c)
+					if err, ok := listErrs[key]; ok {
+						return "", err
+					}
+					ver, ok := installed[key][selectedAddon]
+					if !ok {
+						return "", skipped("the addon is not installed")
+					}
+					m := k.clusterMetadata(c)
+					if critical {
+						if err := k.protectionError(m, "disable the "+selectedAddon+" addon", override); err != nil {
+							return "", skipped(err.Error())
+						}
+					}
+					if err := k.disableAddon(m, selectedAddon, ver); err != nil {
+						return "", err
+					}
+					return "disabled " + selectedAddon + "@" + ver, nil
+				})
+				k.reportBulk("addon disable", results)
+				return
+			}
+
+			m := k.clusterMetadata(clusters[0])
+			if critical {
+				k.guardProtected(m, "disable the "+selectedAddon+" addon", override)
+			}
+
+			if err := k.disableAddon(m, selectedAddon, installed[clusterRecordKey(clusters[0])][selectedAddon]); err != nil {
+				k.l.Error("Error in disabling the addon", "Error", err)
+				os.Exit(1)
 			}
 
 			k.l.Success(k.Ctx, "Addon disabled successfully", "sku", selectedAddon)
 		},
 	}
+
+	cmd.Flags().BoolVar(&override, overrideProtectionFlag, false, "Disable the critical addon even when the cluster is protected")
+	addBulkFlags(cmd, &bulk)
+
 	return cmd
 }
 
-func (k *KsctlCommand) addonClientSetup() (*controller.Metadata, bool) {
-	clusters, err := k.fetchAllClusters()
+// installedAddons returns the version of every installed addon, NaN when it is unknown
+func (k *KsctlCommand) installedAddons(m controller.Metadata) (map[string]string, error) {
+	c, err := addonsHandler.NewController(
+		k.Ctx,
+		k.l,
+		&controller.Client{
+			Metadata: m,
+		},
+	)
 	if err != nil {
-		k.l.Error("Error in fetching the clusters", "Error", err)
-		return nil, false
+		return nil, fmt.Errorf("failed to create the controller: %w", err)
 	}
 
-	if len(clusters) == 0 {
-		k.l.Error("No clusters found to delete")
-		return nil, false
+	addons, err := c.ListInstalledAddons()
+	if err != nil {
+		return nil, err
 	}
 
-	selectDisplay := make(map[string]string, len(clusters))
-	valueMaping := make(map[string]controller.Metadata, len(clusters))
-
-	for idx, cluster := range clusters {
-		selectDisplay[makeHumanReadableList(cluster)] = strconv.Itoa(idx)
-		valueMaping[strconv.Itoa(idx)] = controller.Metadata{
-			ClusterName:   cluster.Name,
-			ClusterType:   cluster.ClusterType,
-			Provider:      cluster.CloudProvider,
-			Region:        cluster.Region,
-			StateLocation: k.KsctlConfig.PreferedStateStore,
-			K8sDistro:     cluster.K8sDistro,
-			K8sVersion:    cluster.K8sVersion,
+	res := make(map[string]string, len(addons))
+	for _, addon := range addons {
+		ver := "NaN"
+		if addon.Version != "" {
+			ver = addon.Version
 		}
+		res[addon.Name] = ver
 	}
+	return res, nil
+}
 
-	selectedCluster, err := k.menuDriven.DropDown(
-		"Select the cluster for addon operation",
-		selectDisplay,
+func (k *KsctlCommand) disableAddon(m controller.Metadata, sku, version string) error {
+	c, err := addonsHandler.NewController(
+		k.Ctx,
+		k.l,
+		&controller.Client{
+			Metadata: m,
+		},
 	)
 	if err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
+		return fmt.Errorf("failed to create the controller: %w", err)
+	}
+
+	cc, err := c.GetAddon(sku)
+	if err != nil {
+		return fmt.Errorf("failed to get the addon: %w", err)
+	}
+
+	if err := k.sendClusterTelemetry(m, telemetry.EventClusterAddonDisable, telemetry.TelemetryMeta{
+		Addons: []telemetry.TelemetryAddon{
+			{
+				Sku:     sku,
+				Version: version,
+			},
+		},
+	}); err != nil {
+		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
+	}
+
+	return cc.Uninstall()
+}
+
+func (k *KsctlCommand) addonClusters(bulk bulkOptions) ([]provider.ClusterData, bool) {
+	clusters, err := k.fetchAllClusters()
+	if err != nil {
+		k.l.Error("Error in fetching the clusters", "Error", err)
+		return nil, false
+	}
+
+	if len(clusters) == 0 {
+		k.l.Error("No clusters found for the addon operation")
 		return nil, false
 	}
 
-	m := valueMaping[selectedCluster]
-	return &m, true
+	return k.targetClusters("Select the clusters for addon operation", clusters, bulk)
 }
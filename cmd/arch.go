@@ -0,0 +1,207 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+const (
+	componentEtcd = "etcd"
+	// releaseAssetTimeout bounds the lookup of every release asset
+	releaseAssetTimeout = 10 * time.Second
+)
+
+// archComponent is a release the nodes of the architecture run
+type archComponent struct {
+	name    string
+	version string
+	arch    provider.MachineArch
+}
+
+func (c archComponent) String() string {
+	v := c.version
+	if len(v) == 0 {
+		v = "default version"
+	}
+	return fmt.Sprintf("%s %s on %s", c.name, v, c.arch)
+}
+
+var exactVersion = regexp.MustCompile(`^v?\d+\.\d+\.\d+`)
+
+// releaseAssetURL returns the url of the binary published for the architecture in the release of the component,
+// false when the component has no per-architecture binaries or the version is not an exact release
+func releaseAssetURL(c archComponent) (string, bool) {
+	v := c.version
+	if !exactVersion.MatchString(v) {
+		return "", false
+	}
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+
+	switch c.name {
+	case string(consts.K8sKubeadm):
+		return fmt.Sprintf("https://dl.k8s.io/release/%s/bin/linux/%s/kubeadm", v, c.arch), true
+	case string(consts.K8sK3s):
+		// the k3s releases are tagged like v1.31.2+k3s1 and the amd64 binary has no suffix
+		if !strings.Contains(v, "+k3s") {
+			return "", false
+		}
+		asset := "k3s"
+		if c.arch != provider.ArchAmd64 {
+			asset += "-" + string(c.arch)
+		}
+		return fmt.Sprintf("https://github.com/k3s-io/k3s/releases/download/%s/%s", v, asset), true
+	case componentEtcd:
+		return fmt.Sprintf("https://github.com/etcd-io/etcd/releases/download/%s/etcd-%s-linux-%s.tar.gz", v, v, c.arch), true
+	case string(consts.CNIFlannel):
+		return fmt.Sprintf("https://github.com/flannel-io/flannel/releases/download/%s/flanneld-%s", v, c.arch), true
+	}
+	return "", false
+}
+
+// releaseAssetPublished looks the asset up, known is false when the release can't be reached
+func releaseAssetPublished(ctx context.Context, url string) (published bool, known bool) {
+	ctx, cancel := context.WithTimeout(ctx, releaseAssetTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, false
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, false
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, true
+	case http.StatusNotFound:
+		return false, true
+	}
+	return false, false
+}
+
+// addonVersion returns the version set in the addon configuration if any
+func addonVersion(config *string) string {
+	if config == nil {
+		return ""
+	}
+	v := map[string]map[string]any{}
+	if err := json.Unmarshal([]byte(*config), &v); err != nil {
+		return ""
+	}
+	for _, c := range v {
+		if ver, ok := c["version"].(string); ok {
+			return ver
+		}
+	}
+	return ""
+}
+
+// archComponents returns the releases the nodes which are not amd64 run. The node image of the
+// managed clusters and their cloud cni come with the instance type from the cloud so they are not listed
+func archComponents(meta controller.Metadata, nodeArchs map[string]provider.MachineArch) []archComponent {
+	res := []archComponent{}
+	add := func(c archComponent) {
+		for _, r := range res {
+			if r == c {
+				return
+			}
+		}
+		res = append(res, c)
+	}
+
+	for _, role := range []string{roleControlPlane, roleWorkerPlane, roleManagedNodes} {
+		arch := nodeArchs[role]
+		if len(arch) == 0 || arch == provider.ArchAmd64 {
+			continue
+		}
+		if meta.ClusterType == consts.ClusterTypeSelfMang {
+			add(archComponent{string(meta.K8sDistro), meta.K8sVersion, arch})
+		}
+		for _, a := range meta.Addons {
+			if !a.IsCNI || a.Name == string(consts.CNINone) {
+				continue
+			}
+			if meta.ClusterType == consts.ClusterTypeMang && a.Name != string(consts.CNIFlannel) && a.Name != string(consts.CNICilium) {
+				continue
+			}
+			add(archComponent{a.Name, addonVersion(a.Config), arch})
+		}
+	}
+
+	if arch := nodeArchs[roleDataStore]; len(arch) != 0 && arch != provider.ArchAmd64 {
+		add(archComponent{componentEtcd, meta.EtcdVersion, arch})
+	}
+	return res
+}
+
+// confirmArchSupport checks that the releases of the distro, etcd and cni are published for the architecture
+// of the nodes running them. It returns false when one of them is not, or when the support can't be confirmed
+// and the user doesn't continue anyway
+func (k *KsctlCommand) confirmArchSupport(meta controller.Metadata, nodeArchs map[string]provider.MachineArch) (bool, error) {
+	components := archComponents(meta, nodeArchs)
+	if len(components) == 0 {
+		return true, nil
+	}
+
+	ss := k.menuDriven.GetProgressAnimation()
+	ss.Start("Checking the releases are published for the cpu architecture")
+
+	missing, unconfirmed := []string{}, []string{}
+	for _, c := range components {
+		url, ok := releaseAssetURL(c)
+		if !ok {
+			unconfirmed = append(unconfirmed, c.String())
+			continue
+		}
+		published, known := releaseAssetPublished(k.Ctx, url)
+		switch {
+		case !known:
+			unconfirmed = append(unconfirmed, c.String())
+		case !published:
+			missing = append(missing, c.String())
+		default:
+			k.l.Debug(k.Ctx, "Release is published for the architecture", "component", c.name, "url", url)
+		}
+	}
+	ss.Stop()
+
+	if len(missing) != 0 {
+		k.l.Warn(k.Ctx, "Releases are not published for the cpu architecture, change the versions or the instance types", "releases", strings.Join(missing, "; "))
+		return false, nil
+	}
+	if len(unconfirmed) == 0 {
+		return true, nil
+	}
+
+	k.l.Warn(k.Ctx, "Support of the cpu architecture can't be confirmed from the release assets", "releases", strings.Join(unconfirmed, "; "))
+	return k.menuDriven.Confirmation("Continue without confirming the support of the cpu architecture?", cli.WithDefaultValue("no"))
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/ksctl/ksctl/v2/pkg/addons"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+func TestReleaseAssetURL(t *testing.T) {
+	for _, tc := range []struct {
+		c    archComponent
+		want string
+		ok   bool
+	}{
+		{archComponent{"kubeadm", "v1.31.2", provider.ArchArm64}, "https://dl.k8s.io/release/v1.31.2/bin/linux/arm64/kubeadm", true},
+		{archComponent{"kubeadm", "1.31.2", provider.ArchArm64}, "https://dl.k8s.io/release/v1.31.2/bin/linux/arm64/kubeadm", true},
+		{archComponent{"k3s", "v1.31.2+k3s1", provider.ArchArm64}, "https://github.com/k3s-io/k3s/releases/download/v1.31.2+k3s1/k3s-arm64", true},
+		{archComponent{"k3s", "v1.31.2+k3s1", provider.ArchAmd64}, "https://github.com/k3s-io/k3s/releases/download/v1.31.2+k3s1/k3s", true},
+		{archComponent{"etcd", "v3.5.15", provider.ArchArm64}, "https://github.com/etcd-io/etcd/releases/download/v3.5.15/etcd-v3.5.15-linux-arm64.tar.gz", true},
+		{archComponent{"flannel", "v0.26.1", provider.ArchArm64}, "https://github.com/flannel-io/flannel/releases/download/v0.26.1/flanneld-arm64", true},
+		{archComponent{"k3s", "v1.31.2", provider.ArchArm64}, "", false},
+		{archComponent{"kubeadm", "v1.31", provider.ArchArm64}, "", false},
+		{archComponent{"flannel", "", provider.ArchArm64}, "", false},
+		{archComponent{"cilium", "v1.16.3", provider.ArchArm64}, "", false},
+	} {
+		got, ok := releaseAssetURL(tc.c)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("releaseAssetURL(%v) = %q, %v, want %q, %v", tc.c, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestArchComponents(t *testing.T) {
+	flannel := `{"flannel": {"version": "v0.26.1"}}`
+	cnis := addons.ClusterAddons{
+		{Name: "flannel", IsCNI: true, Config: &flannel},
+		{Name: "none", IsCNI: true},
+	}
+	selfManaged := controller.Metadata{
+		ClusterType: consts.ClusterTypeSelfMang,
+		K8sDistro:   consts.K8sK3s,
+		K8sVersion:  "v1.31.2+k3s1",
+		EtcdVersion: "v3.5.15",
+		Addons:      cnis,
+	}
+	managed := controller.Metadata{
+		ClusterType: consts.ClusterTypeMang,
+		K8sVersion:  "1.31",
+		Addons:      addons.ClusterAddons{{Name: "aws-vpc-cni", IsCNI: true}, {Name: "cilium", IsCNI: true}},
+	}
+	arm := provider.ArchArm64
+
+	for _, tc := range []struct {
+		name  string
+		meta  controller.Metadata
+		archs map[string]provider.MachineArch
+		want  []archComponent
+	}{
+		{
+			name:  "amd64 nodes need no check",
+			meta:  selfManaged,
+			archs: map[string]provider.MachineArch{roleControlPlane: provider.ArchAmd64, roleWorkerPlane: provider.ArchAmd64, roleDataStore: ""},
+			want:  []archComponent{},
+		},
+		{
+			name:  "arm64 workers need the distro and the cni once",
+			meta:  selfManaged,
+			archs: map[string]provider.MachineArch{roleControlPlane: arm, roleWorkerPlane: arm, roleDataStore: provider.ArchAmd64},
+			want:  []archComponent{{"k3s", "v1.31.2+k3s1", arm}, {"flannel", "v0.26.1", arm}},
+		},
+		{
+			name:  "arm64 datastore needs etcd",
+			meta:  selfManaged,
+			archs: map[string]provider.MachineArch{roleDataStore: arm},
+			want:  []archComponent{{"etcd", "v3.5.15", arm}},
+		},
+		{
+			name:  "managed clusters only check the cni the cloud doesn't provide",
+			meta:  managed,
+			archs: map[string]provider.MachineArch{roleManagedNodes: arm},
+			want:  []archComponent{{"cilium", "", arm}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := archComponents(tc.meta, tc.archs); !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReleaseAssetPublished(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/published":
+			w.WriteHeader(http.StatusOK)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		path             string
+		published, known bool
+	}{
+		{"/published", true, true},
+		{"/missing", false, true},
+		{"/rate-limited", false, false},
+	} {
+		published, known := releaseAssetPublished(context.Background(), srv.URL+tc.path)
+		if published != tc.published || known != tc.known {
+			t.Errorf("releaseAssetPublished(%s) = %v, %v, want %v, %v", tc.path, published, known, tc.published, tc.known)
+		}
+	}
+
+	if _, known := releaseAssetPublished(context.Background(), "http://127.0.0.1:0/unreachable"); known {
+		t.Error("an unreachable release must be unknown")
+	}
+}
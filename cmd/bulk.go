@@ -0,0 +1,198 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+const defaultBulkConcurrency = 4
+
+// errSkipped marks the clusters the operation was not run on, they are not failures
+var errSkipped = errors.New("skipped")
+
+func skipped(reason string) error {
+	return fmt.Errorf("%w: %s", errSkipped, reason)
+}
+
+// bulkOptions target many clusters with one command, it is offered by delete, summary and addons enable/disable.
+// There is no bulk upgrade as the ksctl core can't upgrade a cluster in place
+type bulkOptions struct {
+	selector    string
+	all         bool
+	concurrency int
+}
+
+func addBulkFlags(cmd *cobra.Command, o *bulkOptions) {
+	cmd.Flags().StringVarP(&o.selector, "selector", "l", "", "Label selector of the clusters to operate on, e.g. env=staging")
+	cmd.Flags().BoolVar(&o.all, "all", false, "Operate on all the clusters")
+	cmd.Flags().IntVar(&o.concurrency, "concurrency", defaultBulkConcurrency, "Number of clusters to operate on at the same time")
+	cmd.MarkFlagsMutuallyExclusive("selector", "all")
+}
+
+// targetClusters returns the clusters matched by --all or --selector, otherwise the ones the user picks
+func (k *KsctlCommand) targetClusters(prompt string, clusters []provider.ClusterData, o bulkOptions) ([]provider.ClusterData, bool) {
+	if o.concurrency < 1 {
+		k.l.Error("Invalid concurrency, it must be at least 1", "concurrency", o.concurrency)
+		return nil, false
+	}
+
+	var res []provider.ClusterData
+	switch {
+	case o.all:
+		res = clusters
+	case len(o.selector) != 0:
+		records, err := k.readClusterRecords()
+		if err != nil {
+			k.l.Error("Failed to load the cluster labels", "Reason", err)
+			return nil, false
+		}
+		v, err := selectClusters(clusters, records, o.selector)
+		if err != nil {
+			k.l.Error("Failed to filter the clusters", "Reason", err)
+			return nil, false
+		}
+		res = v
+	default:
+		if len(clusters) == 0 {
+			break
+		}
+		options := make(map[string]string, len(clusters))
+		for idx, c := range clusters {
+			options[makeHumanReadableList(c)] = strconv.Itoa(idx)
+		}
+		v, err := k.menuDriven.MultiSelect(prompt, options)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return nil, false
+		}
+		for _, i := range v {
+			idx, _ := strconv.Atoi(i)
+			res = append(res, clusters[idx])
+		}
+	}
+
+	if len(res) == 0 {
+		k.l.Error("No clusters found to operate on")
+		return nil, false
+	}
+	return res, true
+}
+
+// loadBulkCreds loads the credentials of every cloud of the clusters once, the operations
+// running in parallel must not change the context
+func (k *KsctlCommand) loadBulkCreds(clusters []provider.ClusterData) bool {
+	loaded := map[consts.KsctlCloud]bool{}
+	for _, c := range clusters {
+		if loaded[c.CloudProvider] {
+			continue
+		}
+		if k.loadCloudProviderCreds(c.CloudProvider) != nil {
+			return false
+		}
+		loaded[c.CloudProvider] = true
+	}
+	return true
+}
+
+func (k *KsctlCommand) showTargetClusters(clusters []provider.ClusterData) {
+	rows := make([][]string, 0, len(clusters))
+	for _, c := range clusters {
+		rows = append(rows, []string{c.Name, string(c.ClusterType), string(c.CloudProvider), c.Region})
+	}
+	k.l.Table(k.Ctx, []string{"Name", "Type", "Cloud", "Region"}, rows)
+}
+
+type bulkResult struct {
+	Cluster  provider.ClusterData
+	Detail   string
+	Err      error
+	Duration time.Duration
+}
+
+// runBulk runs the operation on the clusters, at most concurrency of them at a time
+func (k *KsctlCommand) runBulk(operation string, clusters []provider.ClusterData, concurrency int, op func(provider.ClusterData) (string, error)) []bulkResult {
+	results := make([]bulkResult, len(clusters))
+	sem := make(chan struct{}, concurrency)
+	done := atomic.Int32{}
+
+	wg := sync.WaitGroup{}
+	for i, c := range clusters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			k.l.Print(k.Ctx, "Started the "+operation, "cluster", c.Name, "region", c.Region)
+			start := time.Now()
+			detail, err := op(c)
+			results[i] = bulkResult{Cluster: c, Detail: detail, Err: err, Duration: time.Since(start)}
+
+			progress := fmt.Sprintf("%d/%d", done.Add(1), len(clusters))
+			switch {
+			case errors.Is(err, errSkipped):
+				k.l.Note(k.Ctx, "Skipped the "+operation, "cluster", c.Name, "progress", progress, "Reason", err)
+			case err != nil:
+				k.l.Warn(k.Ctx, "Failed the "+operation, "cluster", c.Name, "progress", progress, "Reason", err)
+			default:
+				k.l.Success(k.Ctx, "Finished the "+operation, "cluster", c.Name, "progress", progress)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// reportBulk prints the result of every cluster and exits with an error when any of them failed
+func (k *KsctlCommand) reportBulk(operation string, results []bulkResult) {
+	failed := 0
+	rows := make([][]string, 0, len(results))
+	for _, r := range results {
+		status, detail := color.HiGreenString("done"), r.Detail
+		switch {
+		case errors.Is(r.Err, errSkipped):
+			status, detail = color.HiYellowString("skipped"), r.Err.Error()
+		case r.Err != nil:
+			status = color.HiRedString("failed")
+			failed++
+			if len(detail) != 0 {
+				detail += ": "
+			}
+			detail += r.Err.Error()
+		}
+		rows = append(rows, []string{r.Cluster.Name, string(r.Cluster.CloudProvider), r.Cluster.Region, status, detail, r.Duration.Round(time.Second).String()})
+	}
+
+	fmt.Println()
+	k.l.Table(k.Ctx, []string{"Cluster", "Cloud", "Region", "Status", "Detail", "Duration"}, rows)
+
+	if failed != 0 {
+		k.l.Error("The "+operation+" failed on some of the clusters", "failed", failed, "total", len(results))
+		os.Exit(1)
+	}
+}
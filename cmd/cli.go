@@ -41,6 +41,8 @@ type KsctlCommand struct {
 	KsctlConfig             *config.Config
 	telemetry               *telemetry.Telemetry
 	inMemInstanceTypesInReg provider.InstancesRegionOutput
+	recordStore             config.ClusterRecordStore
+	overrideBudget          bool
 }
 
 func New() (*KsctlCommand, error) {
@@ -0,0 +1,384 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+
+	addonsHandler "github.com/ksctl/ksctl/v2/pkg/handler/addons"
+)
+
+// splitAppVersion splits the "name@version" of the apps and the cni in the cluster state
+func splitAppVersion(app string) (name, version string) {
+	name, version, _ = strings.Cut(strings.TrimSpace(app), "@")
+	return name, version
+}
+
+// equivalentInstances returns the instance types of the architecture with at least the vCPUs and
+// memory of the instance, the ones of the same category first and the cheapest first among them
+func equivalentInstances(vms provider.InstancesRegionOutput, src provider.InstanceRegionOutput) provider.InstancesRegionOutput {
+	res := provider.InstancesRegionOutput{}
+	for _, v := range vms {
+		if v.CpuArch == src.CpuArch && v.VCpus >= src.VCpus && v.Memory >= src.Memory {
+			res = append(res, v)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b provider.InstanceRegionOutput) int {
+		if sa, sb := a.Category == src.Category, b.Category == src.Category; sa != sb {
+			if sa {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(a.GetCost(), b.GetCost())
+	})
+	return res
+}
+
+// cloneRole is the instance type of a role of the source cluster and where its answer goes
+type cloneRole struct {
+	role string
+	step string
+	sku  string
+	vm   *provider.InstanceRegionOutput
+	meta *string
+}
+
+// cloneInstances answers the instance types of the roles with the ones of the source cluster,
+// an equivalent is picked by the user when the region doesn't offer it and the step is asked
+// when there is none. It returns the steps left to ask
+func (w *createWizard) cloneInstances(src provider.ClusterData, roles []cloneRole) ([]string, error) {
+	vms, err := w.k.instanceTypesInRegion(w.metaClient, w.meta)
+	if err != nil {
+		return nil, err
+	}
+
+	var srcVMs provider.InstancesRegionOutput
+	if src.Region == w.meta.Region {
+		srcVMs = vms
+	} else {
+		_vms, err := w.metaClient.ListAllInstances(src.Region)
+		if err != nil {
+			w.k.l.Warn(w.k.Ctx, "Failed to get the instance types of the source region", "region", src.Region, "Reason", err)
+		}
+		srcVMs = _vms
+	}
+
+	w.archs = map[string]provider.MachineArch{}
+	unanswered := []string{}
+
+	for _, r := range roles {
+		if len(r.sku) == 0 {
+			unanswered = append(unanswered, r.step)
+			continue
+		}
+
+		if v, ok := vms.Get(r.sku); ok {
+			*r.vm = *v
+		} else {
+			srcVM, ok := srcVMs.Get(r.sku)
+			if !ok {
+				w.k.l.Warn(w.k.Ctx, "Instance type of the source cluster is unknown, pick one", "role", r.role, "instanceType", r.sku)
+				unanswered = append(unanswered, r.step)
+				continue
+			}
+
+			alternatives := equivalentInstances(vms, *srcVM)
+			if len(alternatives) == 0 {
+				w.k.l.Warn(w.k.Ctx, "No equivalent instance type in the region, pick one", "role", r.role, "instanceType", r.sku, "region", w.meta.Region)
+				unanswered = append(unanswered, r.step)
+				continue
+			}
+
+			w.k.l.Note(w.k.Ctx, fmt.Sprintf("%s is not offered in %s, select an equivalent for %s", r.sku, w.meta.Region, r.role))
+			v, err := w.k.menuDriven.CardSelection(cli.ConverterForInstanceTypesForCards(alternatives))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the instance type from user: %w", err)
+			}
+			alt, ok := alternatives.Get(v)
+			if !ok {
+				return nil, fmt.Errorf("instance type not selected")
+			}
+			w.k.l.Print(w.k.Ctx, "Replaced the instance type", "role", r.role, "from", color.HiRedString(r.sku), "to", color.HiGreenString(alt.Sku))
+			*r.vm = *alt
+		}
+
+		*r.meta = r.vm.Sku
+		w.archs[r.role] = r.vm.CpuArch
+	}
+
+	return unanswered, nil
+}
+
+// cloneVersion keeps the version of the source cluster if it is still offered, otherwise the step is asked
+func (w *createWizard) cloneVersion(version string, list func() ([]string, error), what string) (bool, error) {
+	vers, err := list()
+	if err != nil {
+		return false, fmt.Errorf("failed to get the list of %s versions: %w", what, err)
+	}
+	if slices.Contains(vers, version) {
+		return true, nil
+	}
+	w.k.l.Warn(w.k.Ctx, "Version of the source cluster is not offered, pick one", "component", what, "version", version)
+	return false, nil
+}
+
+// cloneFrom answers the wizard with the configuration of the source cluster
+func (w *createWizard) cloneFrom(src provider.ClusterData) error {
+	m := w.meta
+	m.Provider = src.CloudProvider
+	m.ClusterType = src.ClusterType
+	m.K8sVersion = src.K8sVersion
+
+	if err := w.loadCloudProviderCreds(); err != nil {
+		return err
+	}
+	if err := w.askStorageDriver(); err != nil {
+		return err
+	}
+	if m.ClusterType == consts.ClusterTypeSelfMang {
+		m.K8sDistro = src.K8sDistro
+		m.EtcdVersion = src.EtcdVersion
+	}
+	if err := w.newMetadataClient(); err != nil {
+		return err
+	}
+
+	if m.Provider != consts.CloudLocal {
+		if err := w.fetchRegions(); err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(w.regions, func(r provider.RegionOutput) bool { return r.Sku == m.Region }) {
+			return fmt.Errorf("region %s is not offered by %s", m.Region, m.Provider)
+		}
+	}
+
+	unanswered := []string{}
+
+	if m.ClusterType == consts.ClusterTypeSelfMang {
+		m.NoCP, m.NoWP, m.NoDS = src.NoCP, src.NoWP, src.NoDS
+		w.defaultWP = src.NoWP
+
+		first := func(vms []provider.VMData) string {
+			if len(vms) == 0 {
+				return ""
+			}
+			return vms[0].VMSize
+		}
+		steps, err := w.cloneInstances(src, []cloneRole{
+			{roleControlPlane, stepControlPlane, first(src.CP), &w.cp, &m.ControlPlaneNodeType},
+			{roleDataStore, stepDataStore, first(src.DS), &w.etcd, &m.DataStoreNodeType},
+			{roleLoadBalancer, stepLoadBalancer, src.LB.VMSize, &w.lb, &m.LoadBalancerNodeType},
+			{roleWorkerPlane, stepWorkerPlane, first(src.WP), &w.wp, &m.WorkerPlaneNodeType},
+		})
+		if err != nil {
+			return err
+		}
+		unanswered = append(unanswered, steps...)
+
+		for _, v := range []struct {
+			version string
+			list    func() ([]string, error)
+			what    string
+			step    string
+		}{
+			{m.K8sVersion, w.metaClient.ListAllBootstrapVersions, "bootstrap", stepBootstrapVersion},
+			{m.EtcdVersion, w.metaClient.ListAllEtcdVersions, "etcd", stepEtcdVersion},
+		} {
+			offered, err := w.cloneVersion(v.version, v.list, v.what)
+			if err != nil {
+				return err
+			}
+			if !offered {
+				unanswered = append(unanswered, v.step)
+			}
+		}
+	} else {
+		m.NoMP = src.NoMgt
+		w.defaultMP = src.NoMgt
+
+		steps, err := w.cloneInstances(src, []cloneRole{
+			{roleManagedNodes, stepManagedNodes, src.Mgt.VMSize, &w.vm, &m.ManagedNodeType},
+		})
+		if err != nil {
+			return err
+		}
+		unanswered = append(unanswered, steps...)
+
+		// the state doesn't have the offering of the cluster
+		unanswered = append(unanswered, stepManagedOffering)
+
+		offered, err := w.cloneVersion(m.K8sVersion, func() ([]string, error) {
+			return w.metaClient.ListAllManagedClusterK8sVersions(m.Region)
+		}, "managed cluster k8s")
+		if err != nil {
+			return err
+		}
+		if !offered {
+			unanswered = append(unanswered, stepManagedVersion)
+		}
+	}
+
+	name, _ := splitAppVersion(src.Cni)
+	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := w.listCNIs()
+	if err != nil {
+		return err
+	}
+	if v, err := cniAddons(name, nil, managedCNI, defaultCNI, ksctlCNI, defaultKsctl); err != nil {
+		w.k.l.Warn(w.k.Ctx, "CNI of the source cluster can't be used, pick one", "cni", src.Cni, "Reason", err)
+		unanswered = append(unanswered, stepCNI)
+	} else {
+		m.Addons = v
+	}
+
+	for i, s := range w.steps {
+		if !slices.Contains(unanswered, s.name) {
+			w.answered[s.name] = true
+		}
+		// the cheaper regions are only looked for when the blueprint is edited
+		if s.name == stepCostOptimizer {
+			w.steps[i].auto = func() error { return nil }
+		}
+	}
+	return nil
+}
+
+// installApps installs the apps of the source cluster, the failures only warn as the cluster is already created
+func (k *KsctlCommand) installApps(meta controller.Metadata, apps []string) {
+	if len(apps) == 0 {
+		return
+	}
+
+	c, err := addonsHandler.NewController(k.Ctx, k.l, &controller.Client{Metadata: meta})
+	if err != nil {
+		k.l.Warn(k.Ctx, "Failed to install the apps of the source cluster", "Reason", err)
+		return
+	}
+
+	for _, app := range apps {
+		name, version := splitAppVersion(app)
+		if len(version) == 0 {
+			vers, err := c.ListAvailableVersions(name)
+			if err != nil || len(vers) == 0 {
+				k.l.Warn(k.Ctx, "Failed to get the versions of the app", "app", name, "Reason", err)
+				continue
+			}
+			version = vers[0]
+		}
+
+		a, err := c.GetAddon(name)
+		if err == nil {
+			err = a.Install(version)
+		}
+		if err != nil {
+			k.l.Warn(k.Ctx, "Failed to install the app", "app", name, "version", version, "Reason", err)
+			continue
+		}
+		k.l.Success(k.Ctx, "Installed the app", "app", name, "version", version)
+	}
+}
+
+func (k *KsctlCommand) Clone() *cobra.Command {
+	from := ""
+	name := ""
+	region := ""
+
+	cmd := &cobra.Command{
+		Use: "clone",
+		Example: `
+ksctl cluster clone --from demo --name demo-2
+ksctl cluster clone --from demo --name demo-eu --region westeurope
+`,
+		Short: "Use to create a cluster with the configuration of an existing one",
+		Long:  "It is used to create a cluster with the instance types, node counts, distro, versions, cni and apps of an existing cluster, the instance types not offered in the target region are replaced by the equivalents chosen by the user",
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			src, ok := k.selectClusterByName("Select the cluster to clone", from, clusters)
+			if !ok {
+				os.Exit(1)
+			}
+
+			if err := newClusterNameValidator(clusters)(name); err != nil {
+				k.l.Error("Invalid cluster name", "name", name, "Reason", err)
+				os.Exit(1)
+			}
+			if len(region) != 0 && src.CloudProvider == consts.CloudLocal {
+				k.l.Error("Local clusters have no region", "cluster", src.Name)
+				os.Exit(1)
+			}
+
+			meta := controller.Metadata{
+				ClusterName: name,
+				Region:      src.Region,
+			}
+			if len(region) != 0 {
+				meta.Region = region
+			}
+
+			w := k.newCreateWizard(&meta)
+			// the clone is not a draft, it is created in one go
+			w.onAnswered = nil
+			if err := w.cloneFrom(src); err != nil {
+				k.l.Error("Failed to clone the configuration of the cluster", "cluster", src.Name, "Reason", err)
+				os.Exit(1)
+			}
+
+			if err := w.runWithReview(w.renderBlueprint, "Create the cluster"); err != nil {
+				k.l.Error("Cluster is not created", "Reason", err)
+				os.Exit(1)
+			}
+
+			if err := w.checkBudget(); err != nil {
+				k.l.Error("Operation is blocked by the budget", "Reason", err)
+				os.Exit(1)
+			}
+
+			if meta.ClusterType == consts.ClusterTypeMang {
+				k.createManagedCluster(meta)
+			} else {
+				k.createSelfManagedCluster(meta)
+			}
+			k.installApps(meta, src.Apps)
+
+			k.l.Success(k.Ctx, "Cloned the cluster", "from", src.Name, "Name", meta.ClusterName)
+		},
+	}
+
+	cmd.Flags().StringVar(&from, "from", "", "Name of the cluster to clone")
+	cmd.Flags().StringVar(&name, "name", "", "Name of the new cluster")
+	cmd.Flags().StringVar(&region, "region", "", "Region of the new cluster (default is the region of the source cluster)")
+	k.addOverrideBudgetFlag(cmd)
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
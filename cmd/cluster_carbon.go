@@ -0,0 +1,462 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/logger"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// power model used for the operational emissions, it is the average draw at 50% utilisation
+const (
+	wattsPerVCpu            = 2.12
+	wattsPerMemoryGB        = 0.392
+	powerUsageEffectiveness = 1.135
+)
+
+// embodiedLifetimeMonths is the service life of an instance over which its embodied emissions are spread,
+// the cloud reports them for the whole life of the hardware. 4 years as in the Cloud Carbon Footprint methodology
+const embodiedLifetimeMonths = 48
+
+const (
+	// equivalentCostTolerance is how much costlier an alternative region can be to still be treated as the same cost
+	equivalentCostTolerance = 0.05
+	// maxAlternativeRegions bounds the number of region catalogs fetched per cluster for the suggestion
+	maxAlternativeRegions = 5
+)
+
+type nodeCarbon struct {
+	Role        string  `json:"role"`
+	Sku         string  `json:"sku"`
+	Count       int     `json:"count"`
+	EnergyKWh   float64 `json:"energyKWh"`
+	Operational float64 `json:"operationalKgCo2eq"`
+	// Embodied is nil when the instance type has no embodied emissions data
+	Embodied *float64 `json:"embodiedKgCo2eq"`
+}
+
+type regionSuggestion struct {
+	Region          string  `json:"region"`
+	Name            string  `json:"name"`
+	CarbonIntensity float64 `json:"carbonIntensity"`
+	Operational     float64 `json:"operationalKgCo2eq"`
+	Monthly         float64 `json:"monthly"`
+	Currency        string  `json:"currency"`
+}
+
+type clusterCarbonReport struct {
+	Name            string                  `json:"name"`
+	CloudProvider   consts.KsctlCloud       `json:"cloudProvider"`
+	ClusterType     consts.KsctlClusterType `json:"clusterType"`
+	Region          string                  `json:"region"`
+	CarbonIntensity float64                 `json:"carbonIntensity"`
+	IntensityUnit   string                  `json:"intensityUnit"`
+	EnergyKWh       float64                 `json:"energyKWh"`
+	Operational     float64                 `json:"operationalKgCo2eq"`
+	Embodied        float64                 `json:"embodiedKgCo2eq"`
+	// EmbodiedPartial is set when some of the nodes have no embodied emissions data
+	EmbodiedPartial bool              `json:"embodiedPartial,omitempty"`
+	Monthly         float64           `json:"monthly"`
+	Currency        string            `json:"currency"`
+	Nodes           []nodeCarbon      `json:"nodes"`
+	Suggestion      *regionSuggestion `json:"suggestion,omitempty"`
+}
+
+type fleetCarbonReport struct {
+	GeneratedAt time.Time             `json:"generatedAt"`
+	Clusters    []clusterCarbonReport `json:"clusters"`
+	EnergyKWh   float64               `json:"energyKWh"`
+	Operational float64               `json:"operationalKgCo2eq"`
+	Embodied    float64               `json:"embodiedKgCo2eq"`
+	// EmbodiedPartial is set when some of the nodes have no embodied emissions data
+	EmbodiedPartial bool `json:"embodiedPartial,omitempty"`
+	// Savings is the operational emission avoided if every suggestion is applied
+	Savings float64 `json:"savingsKgCo2eq"`
+}
+
+func (k *KsctlCommand) ClusterCarbon() *cobra.Command {
+	output := ""
+	clusterName := ""
+
+	cmd := &cobra.Command{
+		Use: "carbon",
+		Example: `
+ksctl cluster carbon
+ksctl cluster carbon --name demo
+ksctl cluster carbon --output csv > carbon.csv
+`,
+		Short: "Use to get the carbon footprint of the clusters",
+		Long:  "It is used to estimate the monthly operational and embodied emissions per cluster, the fleet rollup and the lowest carbon region of equivalent cost. Local clusters are excluded as they have no region carbon intensity",
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			local := 0
+			clusters = slices.DeleteFunc(clusters, func(c provider.ClusterData) bool {
+				if len(clusterName) != 0 && c.Name != clusterName {
+					return true
+				}
+				if c.CloudProvider == consts.CloudLocal {
+					local++
+					return true
+				}
+				return false
+			})
+			if local > 0 {
+				k.l.Note(k.Ctx, "Local clusters are excluded as they have no region carbon intensity", "count", local)
+			}
+
+			if len(clusters) == 0 {
+				k.l.Print(k.Ctx, "No cloud clusters found")
+				return
+			}
+
+			report := k.buildFleetCarbonReport(clusters, time.Now().UTC())
+
+			switch output {
+			case cli.OutputJson:
+				err = printJson(report)
+			case cli.OutputCsv:
+				err = printCsvCarbon(report)
+			default:
+				handleTableOutputCarbon(k.Ctx, k.l, report)
+			}
+			if err != nil {
+				k.l.Error("Failed to print the carbon report", "Reason", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson, cli.OutputCsv)
+	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Name of the cluster to report")
+
+	return cmd
+}
+
+// monthlyEnergy returns the estimated kWh consumed by the instance in a month
+func monthlyEnergy(vm provider.InstanceRegionOutput) float64 {
+	watts := float64(vm.VCpus)*wattsPerVCpu + float64(vm.Memory)*wattsPerMemoryGB
+	return watts * powerUsageEffectiveness * hoursInMonth / 1000
+}
+
+// co2Factor returns the factor which converts a mass in the unit, like gCO2eq, into kgCO2eq
+func co2Factor(unit string) (float64, bool) {
+	u := strings.ToLower(strings.TrimSpace(unit))
+	for _, gas := range []string{"co2eq", "co2e", "co2"} {
+		if v, ok := strings.CutSuffix(u, gas); ok {
+			u = strings.TrimSpace(v)
+			break
+		}
+	}
+
+	switch u {
+	case "g":
+		return 1e-3, true
+	case "kg":
+		return 1, true
+	case "t":
+		return 1e3, true
+	}
+	return 0, false
+}
+
+// carbonIntensity returns the direct carbon intensity of the region in kgCO2eq/kWh
+func carbonIntensity(r provider.RegionOutput) (float64, bool) {
+	if r.Emission == nil {
+		return 0, false
+	}
+
+	mass, energy, ok := strings.Cut(r.Emission.Unit, "/")
+	if !ok {
+		return 0, false
+	}
+	f, ok := co2Factor(mass)
+	if !ok {
+		return 0, false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(energy)) {
+	case "kwh":
+		return r.Emission.DirectCarbonIntensity * f, true
+	case "mwh":
+		return r.Emission.DirectCarbonIntensity * f / 1000, true
+	}
+	return 0, false
+}
+
+// operationalEmission converts the energy into kgCO2eq, it is 0 when the region intensity is unknown
+func operationalEmission(energyKWh float64, r provider.RegionOutput) float64 {
+	intensity, _ := carbonIntensity(r)
+	return energyKWh * intensity
+}
+
+// monthlyEmbodied returns the embodied emissions of the instance in kgCO2eq spread over embodiedLifetimeMonths
+func monthlyEmbodied(vm provider.InstanceRegionOutput) (float64, bool) {
+	if vm.EmboddedEmissions == nil {
+		return 0, false
+	}
+	f, ok := co2Factor(vm.EmboddedEmissions.Co2Unit)
+	if !ok {
+		return 0, false
+	}
+	return vm.EmboddedEmissions.EmboddedCo2 * f / embodiedLifetimeMonths, true
+}
+
+func (p *instancePricing) clusterCarbon(cluster provider.ClusterData) (clusterCarbonReport, error) {
+	res := clusterCarbonReport{
+		Name:          cluster.Name,
+		CloudProvider: cluster.CloudProvider,
+		ClusterType:   cluster.ClusterType,
+		Region:        cluster.Region,
+	}
+
+	region, err := p.region(cluster)
+	if err != nil {
+		return res, err
+	}
+	if region.Emission != nil {
+		res.CarbonIntensity = region.Emission.DirectCarbonIntensity
+		res.IntensityUnit = region.Emission.Unit
+		if _, ok := carbonIntensity(region); !ok {
+			return res, fmt.Errorf("unsupported carbon intensity unit %q of the region %s", region.Emission.Unit, region.Sku)
+		}
+	}
+
+	for _, n := range clusterNodes(cluster) {
+		vm, err := p.instance(cluster, n.Sku)
+		if err != nil {
+			return res, err
+		}
+
+		c := nodeCarbon{
+			Role:      n.Role,
+			Sku:       n.Sku,
+			Count:     n.Count,
+			EnergyKWh: float64(n.Count) * monthlyEnergy(vm),
+		}
+		c.Operational = operationalEmission(c.EnergyKWh, region)
+		if e, ok := monthlyEmbodied(vm); ok {
+			e *= float64(n.Count)
+			c.Embodied = &e
+			res.Embodied += e
+		} else {
+			res.EmbodiedPartial = true
+		}
+
+		res.EnergyKWh += c.EnergyKWh
+		res.Operational += c.Operational
+		res.Nodes = append(res.Nodes, c)
+	}
+
+	cost, err := p.clusterCost(cluster)
+	if err != nil {
+		return res, err
+	}
+	cost = cost.inDisplayCurrency()
+	res.Monthly, res.Currency = cost.Total, cost.Currency
+
+	if region.Emission != nil {
+		res.Suggestion = p.lowCarbonRegion(cluster, region, res.EnergyKWh, cost)
+	}
+
+	return res, nil
+}
+
+// lowCarbonRegion returns the region with the lowest carbon intensity where the same
+// instance types cost no more than the current region within the tolerance
+func (p *instancePricing) lowCarbonRegion(cluster provider.ClusterData, current provider.RegionOutput, energyKWh float64, cost clusterCost) *regionSuggestion {
+	regions, err := p.allRegions(cluster)
+	if err != nil {
+		return nil
+	}
+
+	currentIntensity, _ := carbonIntensity(current)
+	candidates := slices.DeleteFunc(slices.Clone(regions), func(r provider.RegionOutput) bool {
+		v, ok := carbonIntensity(r)
+		return !ok || v >= currentIntensity
+	})
+	slices.SortFunc(candidates, func(a, b provider.RegionOutput) int {
+		x, _ := carbonIntensity(a)
+		y, _ := carbonIntensity(b)
+		return cmp.Compare(x, y)
+	})
+	if len(candidates) > maxAlternativeRegions {
+		candidates = candidates[:maxAlternativeRegions]
+	}
+
+	for _, r := range candidates {
+		alt := cluster
+		alt.Region = r.Sku
+
+		c, err := p.clusterCost(alt)
+		if err != nil {
+			// the instance types are not offered in the region
+			continue
+		}
+		c = c.inDisplayCurrency()
+		if c.Currency != cost.Currency || c.Total > cost.Total*(1+equivalentCostTolerance) {
+			continue
+		}
+
+		return &regionSuggestion{
+			Region:          r.Sku,
+			Name:            r.Name,
+			CarbonIntensity: r.Emission.DirectCarbonIntensity,
+			Operational:     operationalEmission(energyKWh, r),
+			Monthly:         c.Total,
+			Currency:        c.Currency,
+		}
+	}
+
+	return nil
+}
+
+func (k *KsctlCommand) buildFleetCarbonReport(clusters []provider.ClusterData, now time.Time) fleetCarbonReport {
+	ss := k.menuDriven.GetProgressAnimation()
+	ss.Start("Estimating the emissions of the clusters")
+
+	pricing := k.newInstancePricing()
+
+	report := fleetCarbonReport{GeneratedAt: now}
+	failed := map[string]error{}
+	for _, cluster := range clusters {
+		c, err := pricing.clusterCarbon(cluster)
+		if err != nil {
+			failed[cluster.Name] = err
+			continue
+		}
+
+		report.EnergyKWh += c.EnergyKWh
+		report.Operational += c.Operational
+		report.Embodied += c.Embodied
+		report.EmbodiedPartial = report.EmbodiedPartial || c.EmbodiedPartial
+		if c.Suggestion != nil {
+			report.Savings += c.Operational - c.Suggestion.Operational
+		}
+		report.Clusters = append(report.Clusters, c)
+	}
+	ss.Stop()
+
+	for name, err := range failed {
+		k.l.Warn(k.Ctx, "Unable to estimate the emissions of the cluster", "name", name, "Reason", err)
+	}
+
+	return report
+}
+
+func printCsvCarbon(report fleetCarbonReport) error {
+	w := csv.NewWriter(os.Stdout)
+
+	_ = w.Write([]string{
+		"cluster", "cloud", "type", "region", "carbon_intensity", "intensity_unit",
+		"energy_kwh", "operational_kgco2eq", "embodied_kgco2eq", "embodied_partial", "monthly_cost", "currency",
+		"suggested_region", "suggested_operational_kgco2eq", "suggested_monthly_cost",
+	})
+
+	f := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 3, 64)
+	}
+
+	for _, c := range report.Clusters {
+		row := []string{
+			c.Name, string(c.CloudProvider), string(c.ClusterType), c.Region, f(c.CarbonIntensity), c.IntensityUnit,
+			f(c.EnergyKWh), f(c.Operational), f(c.Embodied), strconv.FormatBool(c.EmbodiedPartial), f(c.Monthly), c.Currency,
+			"", "", "",
+		}
+		if s := c.Suggestion; s != nil {
+			row[12], row[13], row[14] = s.Region, f(s.Operational), f(s.Monthly)
+		}
+		_ = w.Write(row)
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+func handleTableOutputCarbon(ctx context.Context, l logger.Logger, report fleetCarbonReport) {
+	kg := func(v float64) string {
+		return fmt.Sprintf("%.2f kgCO2eq", v)
+	}
+	embodied := func(v float64, partial bool) string {
+		if partial {
+			return kg(v) + " (partial)"
+		}
+		return kg(v)
+	}
+
+	{
+		headers := []string{"Cluster", "Role", "InstanceType", "Count", "Energy", "Operational", "Embodied"}
+		rows := [][]string{}
+		for _, c := range report.Clusters {
+			for _, n := range c.Nodes {
+				e := "unknown"
+				if n.Embodied != nil {
+					e = kg(*n.Embodied)
+				}
+				rows = append(rows, []string{c.Name, n.Role, n.Sku, strconv.Itoa(n.Count), fmt.Sprintf("%.1f kWh", n.EnergyKWh), kg(n.Operational), e})
+			}
+		}
+		l.Table(ctx, headers, rows)
+		fmt.Println()
+	}
+
+	{
+		headers := []string{"Cluster", "Cloud", "Region", "Intensity", "Operational", "Embodied", "Suggestion"}
+		rows := [][]string{}
+		for _, c := range report.Clusters {
+			suggestion := "-"
+			if s := c.Suggestion; s != nil {
+				suggestion = color.HiGreenString("%s (%s, %s/month)", s.Region, kg(s.Operational), currency.Format(s.Monthly, s.Currency))
+			}
+			rows = append(rows, []string{
+				c.Name,
+				string(c.CloudProvider),
+				c.Region,
+				fmt.Sprintf("%.2f %s", c.CarbonIntensity, c.IntensityUnit),
+				kg(c.Operational),
+				embodied(c.Embodied, c.EmbodiedPartial),
+				suggestion,
+			})
+		}
+		rows = append(rows, []string{"total", "", "", "", kg(report.Operational), embodied(report.Embodied, report.EmbodiedPartial), ""})
+		l.Table(ctx, headers, rows)
+	}
+
+	l.Note(ctx, "Monthly energy of the fleet", "kWh", fmt.Sprintf("%.1f", report.EnergyKWh))
+	if report.Savings > 0 {
+		l.Note(ctx, "Moving to the suggested regions avoids operational emissions", "amount", kg(report.Savings))
+	}
+	l.Note(ctx, "Operational emissions are estimated from the vCPUs and memory of the nodes at 50% utilisation with the direct carbon intensity of the region")
+	l.Note(ctx, "Embodied emissions are the manufacturing emissions of the instance types spread over their lifetime", "months", embodiedLifetimeMonths)
+}
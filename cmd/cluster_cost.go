@@ -0,0 +1,365 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/logger"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// hoursInMonth is the number of hours the cloud providers use to express the monthly price
+const hoursInMonth = 730.0
+
+type clusterCostReport struct {
+	Name          string                  `json:"name"`
+	CloudProvider consts.KsctlCloud       `json:"cloudProvider"`
+	ClusterType   consts.KsctlClusterType `json:"clusterType"`
+	Region        string                  `json:"region"`
+	Currency      string                  `json:"currency"`
+	Roles         []roleCost              `json:"roles"`
+	Monthly       float64                 `json:"monthly"`
+	CreatedAt     *time.Time              `json:"createdAt,omitempty"`
+	// CreatedAtSource is where the creation time comes from, the record or the oldest node
+	CreatedAtSource string            `json:"createdAtSource,omitempty"`
+	Accrued         *float64          `json:"accrued,omitempty"`
+	Owner           string            `json:"owner,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+}
+
+type fleetCostReport struct {
+	GeneratedAt    time.Time                     `json:"generatedAt"`
+	Currency       string                        `json:"currency"`
+	Clusters       []clusterCostReport           `json:"clusters"`
+	MonthlyByCloud map[consts.KsctlCloud]float64 `json:"monthlyByCloud"`
+	Monthly        float64                       `json:"monthly"`
+	Accrued        float64                       `json:"accrued"`
+	// AccruedExcluded is the number of clusters left out of the accrued cost as their creation time is unknown
+	AccruedExcluded int `json:"accruedExcluded,omitempty"`
+	// GroupBy is the label key, or owner, the clusters are rolled up by
+	GroupBy        string             `json:"groupBy,omitempty"`
+	MonthlyByGroup map[string]float64 `json:"monthlyByGroup,omitempty"`
+}
+
+func (k *KsctlCommand) ClusterCost() *cobra.Command {
+	output := ""
+	clusterName := ""
+	selector := ""
+	groupBy := ""
+
+	cmd := &cobra.Command{
+		Use: "cost",
+		Example: `
+ksctl cluster cost
+ksctl cluster cost --name demo
+ksctl cluster cost --output json
+ksctl cluster cost --selector env=staging --group-by team
+ksctl cluster cost --group-by owner
+`,
+		Short: "Use to get the cost report of the clusters",
+		Long:  "It is used to get the estimated monthly cost per role, the accrued cost since creation and a fleet wide rollup of the clusters",
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			if len(clusterName) != 0 {
+				clusters = slices.DeleteFunc(clusters, func(c provider.ClusterData) bool {
+					return c.Name != clusterName
+				})
+			}
+
+			records, ok := k.selectorClusterRecords(selector)
+			if !ok {
+				os.Exit(1)
+			}
+			clusters, err = selectClusters(clusters, records, selector)
+			if err != nil {
+				k.l.Error("Failed to filter the clusters", "Reason", err)
+				os.Exit(1)
+			}
+
+			if len(clusters) == 0 {
+				k.l.Print(k.Ctx, "No clusters found")
+				return
+			}
+
+			report := k.buildFleetCostReport(clusters, groupBy, time.Now().UTC())
+
+			if output == cli.OutputJson {
+				if err := printJson(report); err != nil {
+					k.l.Error("Failed to print the cost report", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			handleTableOutputCost(k.Ctx, k.l, report)
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Name of the cluster to report")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector of the clusters to report, e.g. env=staging")
+	cmd.Flags().StringVar(&groupBy, "group-by", "", "Label key, or owner, to roll up the monthly cost by")
+
+	return cmd
+}
+
+func accruedCost(monthly float64, since, now time.Time) float64 {
+	if since.IsZero() || now.Before(since) {
+		return 0
+	}
+	return monthly * now.Sub(since).Hours() / hoursInMonth
+}
+
+func (k *KsctlCommand) buildFleetCostReport(clusters []provider.ClusterData, groupBy string, now time.Time) fleetCostReport {
+	costs := k.estimateClustersCost(clusters)
+	records := k.loadClusterRecords()
+
+	report := fleetCostReport{
+		GeneratedAt:    now,
+		Currency:       costsCurrency(costs),
+		MonthlyByCloud: make(map[consts.KsctlCloud]float64),
+		GroupBy:        groupBy,
+	}
+	if len(groupBy) != 0 {
+		report.MonthlyByGroup = make(map[string]float64)
+	}
+
+	for _, c := range costs {
+		r := clusterCostReport{
+			Name:          c.Cluster.Name,
+			CloudProvider: c.Cluster.CloudProvider,
+			ClusterType:   c.Cluster.ClusterType,
+			Region:        c.Cluster.Region,
+			Currency:      c.Currency,
+			Roles:         c.Roles,
+			Monthly:       c.Total,
+		}
+		if len(r.Currency) == 0 {
+			r.Currency = report.Currency
+		}
+
+		rec, ok := records.Get(clusterRecordKey(c.Cluster))
+		if ok && len(rec.WorkerPools) != 0 {
+			r.Roles = workerPoolCosts(c.Cluster, r.Roles, workerPoolsOf(c.Cluster, rec))
+		}
+		if ok {
+			r.Owner, r.Labels = rec.Owner, rec.Labels
+		}
+		var createdAt time.Time
+		if ok && !rec.CreatedAt.IsZero() {
+			createdAt, r.CreatedAtSource = rec.CreatedAt, createdFromRecord
+		} else if v, err := k.clusterCreatedFromNodes(c.Cluster); err == nil {
+			createdAt, r.CreatedAtSource = v, createdFromNodes
+		} else {
+			k.l.Debug(k.Ctx, "Unable to get the creation time from the nodes", "cluster", c.Cluster.Name, "Reason", err)
+		}
+		if !createdAt.IsZero() {
+			accrued := accruedCost(c.Total, createdAt, now)
+			r.CreatedAt = &createdAt
+			r.Accrued = &accrued
+			report.Accrued += accrued
+		} else {
+			report.AccruedExcluded++
+		}
+
+		report.Monthly += c.Total
+		report.MonthlyByCloud[c.Cluster.CloudProvider] += c.Total
+		if len(groupBy) != 0 {
+			report.MonthlyByGroup[costGroup(r, groupBy)] += c.Total
+		}
+		report.Clusters = append(report.Clusters, r)
+	}
+
+	return report
+}
+
+// The sources of the creation time of the cluster
+const (
+	createdFromRecord = "record"
+	createdFromNodes  = "nodes"
+)
+
+// clusterCreatedFromNodes returns the creation time of the oldest node, it is when the cluster
+// was created unless all its first nodes have been replaced since
+func (k *KsctlCommand) clusterCreatedFromNodes(c provider.ClusterData) (time.Time, error) {
+	ctx, err := k.withCloudProviderCreds(k.Ctx, c.CloudProvider)
+	if err != nil {
+		return time.Time{}, err
+	}
+	d, err := k.clusterNodeClient(ctx, k.clusterMetadata(c))
+	if err != nil {
+		return time.Time{}, err
+	}
+	created, err := d.nodeCreation(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var oldest time.Time
+	for _, t := range created {
+		if oldest.IsZero() || t.Before(oldest) {
+			oldest = t
+		}
+	}
+	if oldest.IsZero() {
+		return time.Time{}, fmt.Errorf("cluster has no nodes")
+	}
+	return oldest.UTC(), nil
+}
+
+// workerPoolCosts splits the cost of the worker nodes by their pool
+func workerPoolCosts(cluster provider.ClusterData, roles []roleCost, pools []config.WorkerPool) []roleCost {
+	poolOf := map[string]string{}
+	for _, p := range pools {
+		for _, n := range p.Nodes {
+			poolOf[n] = p.Name
+		}
+	}
+
+	res := make([]roleCost, 0, len(roles))
+	for _, r := range roles {
+		if r.Role != roleWorkerPlane || r.Count == 0 {
+			res = append(res, r)
+			continue
+		}
+
+		unit := r.Monthly / float64(r.Count)
+		order, counts := []string{}, map[string]int{}
+		for _, vm := range cluster.WP {
+			if vm.VMSize != r.Sku {
+				continue
+			}
+			p := poolOf[vm.VMName]
+			if _, ok := counts[p]; !ok {
+				order = append(order, p)
+			}
+			counts[p]++
+		}
+		for _, p := range order {
+			res = append(res, roleCost{Role: r.Role, Pool: p, Sku: r.Sku, Count: counts[p], Monthly: unit * float64(counts[p])})
+		}
+	}
+	return res
+}
+
+// costGroup is the value of the label, or the owner, the cluster is rolled up by
+func costGroup(r clusterCostReport, groupBy string) string {
+	v := r.Labels[groupBy]
+	if groupBy == ownerGroup {
+		v = r.Owner
+	}
+	if len(v) == 0 {
+		return "<none>"
+	}
+	return v
+}
+
+func printJson(v any) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+func handleTableOutputCost(ctx context.Context, l logger.Logger, report fleetCostReport) {
+	money := func(v float64) string {
+		return currency.Format(v, report.Currency)
+	}
+
+	{
+		headers := []string{"Cluster", "Role", "InstanceType", "Count", "Monthly"}
+		rows := [][]string{}
+		for _, c := range report.Clusters {
+			for _, r := range c.Roles {
+				role := r.Role
+				if len(r.Pool) != 0 {
+					role += " (" + r.Pool + ")"
+				}
+				rows = append(rows, []string{c.Name, role, r.Sku, strconv.Itoa(r.Count), currency.Format(r.Monthly, c.Currency)})
+			}
+		}
+		if len(rows) > 0 {
+			l.Table(ctx, headers, rows)
+			fmt.Println()
+		}
+	}
+
+	{
+		headers := []string{"Cluster", "Type", "Cloud", "Region", "Created", "Monthly", "Accrued"}
+		rows := [][]string{}
+		for _, c := range report.Clusters {
+			created, accrued := "unknown", "unknown"
+			if c.CreatedAt != nil {
+				created = c.CreatedAt.Local().Format(time.DateTime)
+				if c.CreatedAtSource == createdFromNodes {
+					created += " (oldest node)"
+				}
+				accrued = currency.Format(*c.Accrued, c.Currency)
+			}
+			rows = append(rows, []string{c.Name, string(c.ClusterType), string(c.CloudProvider), c.Region, created, currency.Format(c.Monthly, c.Currency), accrued})
+		}
+		l.Table(ctx, headers, rows)
+		fmt.Println()
+	}
+
+	{
+		headers := []string{"Fleet", "Monthly"}
+		rows := [][]string{}
+		for cloud, v := range report.MonthlyByCloud {
+			rows = append(rows, []string{string(cloud), money(v)})
+		}
+		slices.SortFunc(rows, func(a, b []string) int {
+			return strings.Compare(a[0], b[0])
+		})
+		rows = append(rows, []string{"total", money(report.Monthly)})
+		l.Table(ctx, headers, rows)
+	}
+
+	if len(report.GroupBy) != 0 {
+		fmt.Println()
+		rows := [][]string{}
+		for _, g := range slices.Sorted(maps.Keys(report.MonthlyByGroup)) {
+			rows = append(rows, []string{g, money(report.MonthlyByGroup[g])})
+		}
+		l.Table(ctx, []string{report.GroupBy, "Monthly"}, rows)
+	}
+
+	l.Note(ctx, "Accrued cost of the fleet since creation", "amount", money(report.Accrued))
+	if report.AccruedExcluded > 0 {
+		l.Warn(ctx, "Accrued cost of the fleet leaves out the clusters without a known creation time", "count", report.AccruedExcluded)
+	}
+	l.Note(ctx, "Managed clusters are priced with the lowest control plane offering of the region")
+}
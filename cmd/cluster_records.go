@@ -0,0 +1,121 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+// recordStoreMu guards opening the record store by the operations running in parallel
+var recordStoreMu sync.Mutex
+
+func clusterRecordKey(c provider.ClusterData) string {
+	return config.ClusterRecordKey(c.Name, c.CloudProvider, c.ClusterType, c.Region)
+}
+
+func metadataRecordKey(m controller.Metadata) string {
+	return config.ClusterRecordKey(m.ClusterName, m.Provider, m.ClusterType, m.Region)
+}
+
+// clusterRecordStore returns the cluster records of the configured state store, it is opened once
+func (k *KsctlCommand) clusterRecordStore() (config.ClusterRecordStore, error) {
+	recordStoreMu.Lock()
+	defer recordStoreMu.Unlock()
+
+	if k.recordStore == nil {
+		s, err := config.NewClusterRecordStore(k.Ctx, k.KsctlConfig.PreferedStateStore)
+		if err != nil {
+			return nil, err
+		}
+		k.recordStore = s
+	}
+	return k.recordStore, nil
+}
+
+func (k *KsctlCommand) readClusterRecords() (*config.ClusterRecords, error) {
+	s, err := k.clusterRecordStore()
+	if err != nil {
+		return nil, err
+	}
+	r := new(config.ClusterRecords)
+	if err := s.Load(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (k *KsctlCommand) loadClusterRecords() *config.ClusterRecords {
+	r, err := k.readClusterRecords()
+	if err != nil {
+		k.l.Warn(k.Ctx, "Failed to load the cluster records", "Reason", err)
+		r = &config.ClusterRecords{Clusters: make(map[string]*config.ClusterRecord)}
+	}
+	return r
+}
+
+// selectorClusterRecords returns the cluster records to filter with the selector, the labels
+// are required for a selector so only an empty one carries on when the records can't be read
+func (k *KsctlCommand) selectorClusterRecords(selector string) (*config.ClusterRecords, bool) {
+	if len(selector) == 0 {
+		return k.loadClusterRecords(), true
+	}
+	r, err := k.readClusterRecords()
+	if err != nil {
+		k.l.Error("Failed to load the cluster labels", "Reason", err)
+		return nil, false
+	}
+	return r, true
+}
+
+// updateClusterRecord applies the change to the record of the cluster and persists it
+func (k *KsctlCommand) updateClusterRecord(m controller.Metadata, change func(*config.ClusterRecord)) error {
+	s, err := k.clusterRecordStore()
+	if err != nil {
+		return err
+	}
+	return s.Update(config.ClusterRecord{
+		Name:          m.ClusterName,
+		CloudProvider: m.Provider,
+		ClusterType:   m.ClusterType,
+		Region:        m.Region,
+	}, change)
+}
+
+func (k *KsctlCommand) recordClusterCreated(m controller.Metadata) {
+	if err := k.updateClusterRecord(m, func(r *config.ClusterRecord) {
+		r.CreatedAt = time.Now().UTC()
+	}); err != nil {
+		k.l.Warn(k.Ctx, "Failed to record the cluster creation", "Reason", err)
+	}
+}
+
+func (k *KsctlCommand) forgetCluster(m controller.Metadata) {
+	k.forgetClusterRecord(metadataRecordKey(m))
+}
+
+func (k *KsctlCommand) forgetClusterRecord(key string) {
+	s, err := k.clusterRecordStore()
+	if err == nil {
+		err = s.Delete(key)
+	}
+	if err != nil {
+		k.l.Warn(k.Ctx, "Failed to update the cluster records", "Reason", err)
+	}
+}
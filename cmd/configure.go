@@ -17,12 +17,17 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/ksctl/cli/v2/pkg/cli"
 	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/statefile"
 	"github.com/ksctl/ksctl/v2/pkg/utilities"
@@ -51,6 +56,18 @@ func (k *KsctlCommand) Configure() *cobra.Command {
 				{"Telemetry", telemetry},
 			}
 
+			if c := k.KsctlConfig.Currency; c != nil && len(c.Display) != 0 {
+				rows = append(rows, []string{"Currency 💱", fmt.Sprintf("%s (%s)", c.Display, currency.Default().Locale)})
+			} else {
+				rows = append(rows, []string{"Currency 💱", "catalog currency"})
+			}
+
+			if b := k.KsctlConfig.Budget; b.IsConfigured() {
+				rows = append(rows, []string{"Budget 💰", fmt.Sprintf("account: %s, cluster: %s (%s)", k.budgetAmount(b.AccountMonthly), k.budgetAmount(b.ClusterMonthly), budgetMode(b.Enforcement))})
+			} else {
+				rows = append(rows, []string{"Budget 💰", disabled})
+			}
+
 			if k.KsctlConfig.PreferedStateStore == consts.StoreExtMongo {
 				if err := k.loadMongoCredentials(); err != nil {
 					rows = append(rows, []string{"MongoDB 💾", disabled})
@@ -133,6 +150,45 @@ func (k *KsctlCommand) ConfigureTelemetry() *cobra.Command {
 	return cmd
 }
 
+func (k *KsctlCommand) ConfigureBudget() *cobra.Command {
+	clusterName := ""
+
+	cmd := &cobra.Command{
+		Use: "budget",
+		Example: `
+ksctl configure budget
+ksctl configure budget --cluster demo
+`,
+		Short: "Configure monthly budget",
+		Long:  "It will help you to configure the monthly budget for the account and per cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			if ok := k.handleBudgetConfig(clusterName); !ok {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&clusterName, "cluster", "", "Set the budget override for a specific cluster")
+
+	return cmd
+}
+
+func (k *KsctlCommand) ConfigureCurrency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "currency",
+
+		Short: "Configure display currency",
+		Long:  "It will help you to configure the currency and locale used to display the prices along with the exchange rates file",
+		Run: func(cmd *cobra.Command, args []string) {
+			if ok := k.handleCurrencyConfig(); !ok {
+				os.Exit(1)
+			}
+		},
+	}
+
+	return cmd
+}
+
 func (k *KsctlCommand) handleStorageConfig() bool {
 	if v, err := k.menuDriven.DropDown(
 		"What should be your default storageDriver?",
@@ -191,6 +247,176 @@ func (k *KsctlCommand) handleCloudConfig() bool {
 	return true
 }
 
+func (k *KsctlCommand) handleBudgetConfig(clusterName string) bool {
+	b := k.KsctlConfig.Budget
+	if b == nil {
+		b = new(config.Budget)
+	}
+
+	getAmount := func(prompt string, defaultVal float64) (float64, bool) {
+		v, err := k.getFloatValue(prompt, func(v float64) error {
+			if v < 0 {
+				return fmt.Errorf("amount must not be negative")
+			}
+			return nil
+		}, defaultVal)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return 0, false
+		}
+		return v, true
+	}
+
+	if len(clusterName) != 0 {
+		v, ok := getAmount(fmt.Sprintf("Enter the monthly budget for cluster %s (0 to remove the override)", clusterName), b.LimitForCluster(clusterName))
+		if !ok {
+			return false
+		}
+		if b.Clusters == nil {
+			b.Clusters = make(map[string]float64)
+		}
+		if v == 0 {
+			delete(b.Clusters, clusterName)
+		} else {
+			b.Clusters[clusterName] = v
+		}
+	} else {
+		codes := append(currency.KnownCodes(), otherCurrency)
+		def := k.budgetCurrency()
+		if !slices.Contains(codes, def) {
+			def = otherCurrency
+		}
+		code, err := k.menuDriven.DropDownList("Which currency are the budget limits in?", codes, cli.WithDefaultValue(def))
+		if err == nil && code == otherCurrency {
+			code, err = k.currencyCodeInput("Enter the ISO 4217 code of the budget currency", k.budgetCurrency())
+		}
+		if err != nil {
+			k.l.Error("Failed to get the budget currency", "Reason", err)
+			return false
+		}
+		b.Currency = code
+
+		v, ok := getAmount("Enter the monthly budget for all the clusters (0 for no limit)", b.AccountMonthly)
+		if !ok {
+			return false
+		}
+		b.AccountMonthly = v
+
+		v, ok = getAmount("Enter the default monthly budget per cluster (0 for no limit)", b.ClusterMonthly)
+		if !ok {
+			return false
+		}
+		b.ClusterMonthly = v
+
+		mode, err := k.menuDriven.DropDown(
+			"What should happen when a create or scaleup exceeds the budget?",
+			map[string]string{
+				"Warn and continue":   string(config.BudgetWarn),
+				"Block the operation": string(config.BudgetBlock),
+			},
+			cli.WithDefaultValue(budgetMode(b.Enforcement)),
+		)
+		if err != nil {
+			k.l.Error("Failed to get the budget enforcement", "Reason", err)
+			return false
+		}
+		b.Enforcement = config.BudgetEnforcement(mode)
+	}
+
+	k.KsctlConfig.Budget = b
+	if err := config.SaveConfig(k.KsctlConfig); err != nil {
+		k.l.Error("Failed to save the configuration", "Reason", err)
+		return false
+	}
+
+	k.l.Success(k.Ctx, "Budget configured successfully")
+	return true
+}
+
+// otherCurrency is the option to enter an ISO code which has no dedicated symbol
+const otherCurrency = "Other ISO 4217 code"
+
+func (k *KsctlCommand) currencyCodeInput(prompt, def string) (string, error) {
+	code, err := k.menuDriven.TextInput(prompt,
+		cli.WithDefaultValue(def),
+		cli.WithValidator(func(v string) error {
+			if !currency.IsValidCode(strings.ToUpper(strings.TrimSpace(v))) {
+				return fmt.Errorf("%q is not a 3 letter ISO 4217 code", v)
+			}
+			return nil
+		}),
+	)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(strings.TrimSpace(code)), nil
+}
+
+func (k *KsctlCommand) handleCurrencyConfig() bool {
+	c := k.KsctlConfig.Currency
+	if c == nil {
+		c = new(config.Currency)
+	}
+
+	const catalog = "As per the cloud catalog"
+	options := map[string]string{catalog: "", otherCurrency: otherCurrency}
+	for _, code := range currency.KnownCodes() {
+		options[fmt.Sprintf("%s (%s)", code, currency.FormatIn(1234.5, code, currency.Default().Locale))] = code
+	}
+
+	display, err := k.menuDriven.DropDown(
+		"Which currency should be used to display the prices?",
+		options,
+		cli.WithDefaultValue(catalog),
+	)
+	if err == nil && display == otherCurrency {
+		display, err = k.currencyCodeInput("Enter the ISO 4217 code of the display currency", c.Display)
+	}
+	if err != nil {
+		k.l.Error("Failed to get the display currency", "Reason", err)
+		return false
+	}
+	c.Display = display
+
+	locale, err := k.menuDriven.TextInput("Enter the locale for formatting (for ex. en-US, de-DE, en-IN), leave empty to use the environment", cli.WithDefaultValue(c.Locale))
+	if err != nil {
+		k.l.Error("Failed to get userinput", "Reason", err)
+		return false
+	}
+	c.Locale = locale
+
+	if len(c.Display) != 0 {
+		ratesFile, err := k.menuDriven.TextInput("Enter the path of the exchange rates file (JSON with base, rates and updatedAt)", cli.WithDefaultValue(c.RatesFile))
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return false
+		}
+		c.RatesFile = ratesFile
+	}
+
+	if len(c.RatesFile) != 0 {
+		r, err := config.ReadRatesFile(c.RatesFile)
+		if err != nil {
+			k.l.Error("Failed to read the exchange rates", "Reason", err)
+			return false
+		}
+		if err := config.SaveRatesCache(r); err != nil {
+			k.l.Error("Failed to cache the exchange rates", "Reason", err)
+			return false
+		}
+		k.l.Print(k.Ctx, "Exchange rates cached", "base", r.Base, "updatedAt", r.UpdatedAt.Local().Format(time.DateTime))
+	}
+
+	k.KsctlConfig.Currency = c
+	if err := config.SaveConfig(k.KsctlConfig); err != nil {
+		k.l.Error("Failed to save the configuration", "Reason", err)
+		return false
+	}
+
+	k.l.Success(k.Ctx, "Currency configured successfully")
+	return true
+}
+
 func (k *KsctlCommand) storeAwsCredentials() (err error) {
 	c := new(statefile.CredentialsAws)
 	c.AccessKeyId, err = k.menuDriven.TextInputPassword("Enter your AWS Access Key ID")
@@ -288,7 +514,12 @@ func (k *KsctlCommand) storeMongoCredentials() (err error) {
 	return config.SaveStorageCreds(c, consts.StoreExtMongo)
 }
 
+// loadMongoCredentials adds the credentials to the context once, the scheduler reads the
+// state every minute and the context must not grow with it
 func (k *KsctlCommand) loadMongoCredentials() error {
+	if k.Ctx.Value(consts.KsctlMongodbCredentials) != nil {
+		return nil
+	}
 	c := new(statefile.CredentialsMongodb)
 	if err := config.LoadStorageCreds(c, consts.StoreExtMongo); err != nil {
 		return err
@@ -87,7 +87,7 @@ ksctl connect --help
 
 			m := valueMaping[selectedCluster]
 
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterConnect, telemetry.TelemetryMeta{
+			if err := k.sendClusterTelemetry(m, telemetry.EventClusterConnect, telemetry.TelemetryMeta{
 				CloudProvider:     m.Provider,
 				StorageDriver:     m.StateLocation,
 				Region:            m.Region,
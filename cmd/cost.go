@@ -0,0 +1,589 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+func (k *KsctlCommand) Cost() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "cost",
+		Example: `
+ksctl cost --help
+`,
+		Short: "Use to work with the cost of clusters",
+		Long:  "It is used to work with the estimated cost of the clusters",
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) CostBudget() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "budget",
+		Example: `
+ksctl cost budget --help
+`,
+		Short: "Use to show the budget headroom",
+		Long:  "It is used to show the monthly budget and how much of it is left for the clusters",
+		Run: func(cmd *cobra.Command, args []string) {
+			b := k.KsctlConfig.Budget
+			if !b.IsConfigured() {
+				k.l.Note(k.Ctx, "No budget is configured", "hint", "use $ksctl configure budget")
+				return
+			}
+
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			costs := k.estimateClustersCost(clusters)
+
+			headers := []string{"Scope", "Limit", "Estimated", "Headroom"}
+			rows := [][]string{}
+			code := k.budgetCurrency()
+
+			total, unpriced := 0.0, len(clusters)-len(costs)
+			clusterRows := [][]string{}
+			for _, c := range costs {
+				v, err := k.toBudgetCurrency(c.Total, c.Currency)
+				if err != nil {
+					k.l.Warn(k.Ctx, "Unable to convert the cost of the cluster into the budget currency", "name", c.Cluster.Name, "Reason", err)
+					unpriced++
+					continue
+				}
+				total += v
+				if limit := b.LimitForCluster(c.Cluster.Name); limit > 0 {
+					clusterRows = append(clusterRows, budgetRow(makeHumanReadableList(c.Cluster), limit, v, code))
+				}
+			}
+
+			if b.AccountMonthly > 0 {
+				rows = append(rows, budgetRow("account", b.AccountMonthly, total, code))
+			}
+			rows = append(rows, clusterRows...)
+
+			k.l.Table(k.Ctx, headers, rows)
+			if unpriced != 0 {
+				k.l.Warn(k.Ctx, "The estimate is incomplete, some clusters couldn't be priced", "clusters", unpriced)
+			}
+			k.l.Note(k.Ctx, "Budget enforcement", "mode", budgetMode(b.Enforcement), "currency", code)
+		},
+	}
+
+	return cmd
+}
+
+func budgetRow(scope string, limit, estimated float64, code string) []string {
+	locale := currency.Default().Locale
+	headroom := limit - estimated
+	h := currency.FormatIn(headroom, code, locale)
+	if headroom < 0 {
+		h = color.HiRedString(h)
+	} else {
+		h = color.HiGreenString(h)
+	}
+	return []string{
+		scope,
+		currency.FormatIn(limit, code, locale),
+		currency.FormatIn(estimated, code, locale),
+		h,
+	}
+}
+
+type roleCost struct {
+	Role    string  `json:"role"`
+	Pool    string  `json:"pool,omitempty"`
+	Sku     string  `json:"sku"`
+	Count   int     `json:"count"`
+	Monthly float64 `json:"monthly"`
+}
+
+type clusterCost struct {
+	Cluster  provider.ClusterData
+	Currency string
+	Roles    []roleCost
+	Total    float64
+}
+
+// inDisplayCurrency converts the cost into the display currency so that
+// clusters priced from different catalogs can be compared and summed
+func (c clusterCost) inDisplayCurrency() clusterCost {
+	if len(c.Currency) == 0 {
+		return c
+	}
+
+	total, code := currency.Convert(c.Total, c.Currency)
+	if code == c.Currency {
+		return c
+	}
+
+	roles := make([]roleCost, len(c.Roles))
+	for i, r := range c.Roles {
+		r.Monthly, _ = currency.Convert(r.Monthly, c.Currency)
+		roles[i] = r
+	}
+
+	c.Roles, c.Total, c.Currency = roles, total, code
+	return c
+}
+
+const (
+	roleControlPlane    = "ControlPlane"
+	roleWorkerPlane     = "WorkerPlane"
+	roleDataStore       = "Etcd"
+	roleLoadBalancer    = "LoadBalancer"
+	roleManagedNodes    = "ManagedNodes"
+	roleManagedOffering = "ManagedOffering"
+)
+
+// instancePricing caches the region, instance and managed offering catalogs per provider region
+// so that the fleet is priced with a single lookup per region
+type instancePricing struct {
+	k *KsctlCommand
+	// mu guards the caches and ctx, the catalogs are fetched without holding it
+	mu sync.Mutex
+	// ctx carries the credentials of the clouds in creds, k.Ctx is never written so the
+	// lookups can run in parallel
+	ctx       context.Context
+	creds     map[consts.KsctlCloud]bool
+	regions   map[consts.KsctlCloud]provider.RegionsOutput
+	vms       map[string]provider.InstancesRegionOutput
+	offerings map[string]map[string]provider.ManagedClusterOutput
+}
+
+func (k *KsctlCommand) newInstancePricing() *instancePricing {
+	return &instancePricing{
+		k:         k,
+		ctx:       k.Ctx,
+		creds:     make(map[consts.KsctlCloud]bool),
+		regions:   make(map[consts.KsctlCloud]provider.RegionsOutput),
+		vms:       make(map[string]provider.InstancesRegionOutput),
+		offerings: make(map[string]map[string]provider.ManagedClusterOutput),
+	}
+}
+
+// loadCreds loads the credentials of the cloud once, call it before pricing the cloud in parallel
+func (p *instancePricing) loadCreds(cloud consts.KsctlCloud) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.creds[cloud] {
+		return nil
+	}
+	ctx, err := p.k.withCloudProviderCreds(p.ctx, cloud)
+	if err != nil {
+		return err
+	}
+	p.ctx = ctx
+	p.creds[cloud] = true
+	return nil
+}
+
+func (p *instancePricing) metaClient(cluster provider.ClusterData) (*controllerMeta.Controller, error) {
+	if err := p.loadCreds(cluster.CloudProvider); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	ctx := p.ctx
+	p.mu.Unlock()
+
+	return controllerMeta.NewController(
+		ctx,
+		p.k.l,
+		&controller.Client{
+			Metadata: controller.Metadata{
+				ClusterName:   cluster.Name,
+				ClusterType:   cluster.ClusterType,
+				Provider:      cluster.CloudProvider,
+				Region:        cluster.Region,
+				StateLocation: p.k.KsctlConfig.PreferedStateStore,
+				K8sDistro:     cluster.K8sDistro,
+			},
+		},
+	)
+}
+
+func (p *instancePricing) allRegions(cluster provider.ClusterData) (provider.RegionsOutput, error) {
+	p.mu.Lock()
+	v, ok := p.regions[cluster.CloudProvider]
+	p.mu.Unlock()
+	if ok {
+		return v, nil
+	}
+
+	metaClient, err := p.metaClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	regions, err := metaClient.ListAllRegions()
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.regions[cluster.CloudProvider] = regions
+	p.mu.Unlock()
+	return regions, nil
+}
+
+func (p *instancePricing) region(cluster provider.ClusterData) (provider.RegionOutput, error) {
+	regions, err := p.allRegions(cluster)
+	if err != nil {
+		return provider.RegionOutput{}, err
+	}
+	for _, r := range regions {
+		if r.Sku == cluster.Region {
+			return r, nil
+		}
+	}
+	return provider.RegionOutput{}, fmt.Errorf("region %s not found for %s", cluster.Region, cluster.CloudProvider)
+}
+
+func (p *instancePricing) instances(cluster provider.ClusterData) (provider.InstancesRegionOutput, error) {
+	key := string(cluster.CloudProvider) + "/" + cluster.Region
+	p.mu.Lock()
+	v, ok := p.vms[key]
+	p.mu.Unlock()
+	if ok {
+		return v, nil
+	}
+
+	metaClient, err := p.metaClient(cluster)
+	if err != nil {
+		return nil, err
+	}
+	vms, err := metaClient.ListAllInstances(cluster.Region)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.vms[key] = vms
+	p.mu.Unlock()
+	return vms, nil
+}
+
+func (p *instancePricing) instance(cluster provider.ClusterData, sku string) (provider.InstanceRegionOutput, error) {
+	vms, err := p.instances(cluster)
+	if err != nil {
+		return provider.InstanceRegionOutput{}, err
+	}
+
+	v, ok := vms.Get(sku)
+	if !ok {
+		return provider.InstanceRegionOutput{}, fmt.Errorf("instance type %s not found in %s/%s", sku, cluster.CloudProvider, cluster.Region)
+	}
+	return *v, nil
+}
+
+// managedOffering returns the lowest priced managed control plane offering
+// as the offering chosen at creation time is not part of the cluster state
+func (p *instancePricing) managedOffering(cluster provider.ClusterData) (*provider.ManagedClusterOutput, error) {
+	key := string(cluster.CloudProvider) + "/" + cluster.Region
+	p.mu.Lock()
+	offerings, ok := p.offerings[key]
+	p.mu.Unlock()
+	if !ok {
+		metaClient, err := p.metaClient(cluster)
+		if err != nil {
+			return nil, err
+		}
+		offerings, err = metaClient.ListAllManagedClusterManagementOfferings(cluster.Region, nil)
+		if err != nil {
+			return nil, err
+		}
+		p.mu.Lock()
+		p.offerings[key] = offerings
+		p.mu.Unlock()
+	}
+
+	var cheapest *provider.ManagedClusterOutput
+	for _, o := range offerings {
+		if cheapest == nil || o.GetCost() < cheapest.GetCost() {
+			cheapest = &o
+		}
+	}
+	return cheapest, nil
+}
+
+type roleNodes struct {
+	Role  string
+	Sku   string
+	Count int
+}
+
+// clusterNodes groups the nodes of the cluster by role and instance type
+func clusterNodes(cluster provider.ClusterData) []roleNodes {
+	res := []roleNodes{}
+
+	add := func(role string, sku string, count int) {
+		if len(sku) == 0 || count == 0 {
+			return
+		}
+		for i := range res {
+			if res[i].Role == role && res[i].Sku == sku {
+				res[i].Count += count
+				return
+			}
+		}
+		res = append(res, roleNodes{Role: role, Sku: sku, Count: count})
+	}
+
+	if cluster.ClusterType == consts.ClusterTypeMang {
+		add(roleManagedNodes, cluster.Mgt.VMSize, cluster.NoMgt)
+		return res
+	}
+
+	for _, vm := range cluster.CP {
+		add(roleControlPlane, vm.VMSize, 1)
+	}
+	for _, vm := range cluster.WP {
+		add(roleWorkerPlane, vm.VMSize, 1)
+	}
+	for _, vm := range cluster.DS {
+		add(roleDataStore, vm.VMSize, 1)
+	}
+	add(roleLoadBalancer, cluster.LB.VMSize, 1)
+
+	slices.SortFunc(res, func(a, b roleNodes) int {
+		return strings.Compare(a.Role+a.Sku, b.Role+b.Sku)
+	})
+
+	return res
+}
+
+func (p *instancePricing) clusterCost(cluster provider.ClusterData) (clusterCost, error) {
+	res := clusterCost{Cluster: cluster}
+
+	if cluster.CloudProvider == consts.CloudLocal {
+		return res, nil
+	}
+
+	for _, n := range clusterNodes(cluster) {
+		vm, err := p.instance(cluster, n.Sku)
+		if err != nil {
+			return res, err
+		}
+		res.Currency = vm.Price.Currency
+
+		cost := float64(n.Count) * vm.GetCost()
+		res.Total += cost
+		res.Roles = append(res.Roles, roleCost{Role: n.Role, Sku: n.Sku, Count: n.Count, Monthly: cost})
+	}
+
+	if cluster.ClusterType == consts.ClusterTypeMang {
+		o, err := p.managedOffering(cluster)
+		if err != nil {
+			return res, err
+		}
+		if o != nil {
+			res.Total += o.GetCost()
+			res.Roles = append(res.Roles, roleCost{Role: roleManagedOffering, Sku: o.Sku, Count: 1, Monthly: o.GetCost()})
+		}
+	}
+
+	return res, nil
+}
+
+func (k *KsctlCommand) estimateClustersCost(clusters []provider.ClusterData) []clusterCost {
+	ss := k.menuDriven.GetProgressAnimation()
+	ss.Start("Estimating the cost of the clusters")
+
+	pricing := k.newInstancePricing()
+
+	res := make([]clusterCost, 0, len(clusters))
+	failed := map[string]error{}
+	for _, cluster := range clusters {
+		c, err := pricing.clusterCost(cluster)
+		if err != nil {
+			failed[cluster.Name] = err
+			continue
+		}
+		res = append(res, c.inDisplayCurrency())
+	}
+	ss.Stop()
+
+	for name, err := range failed {
+		k.l.Warn(k.Ctx, "Unable to estimate the cost of the cluster", "name", name, "Reason", err)
+	}
+
+	code := costsCurrency(res)
+	for _, c := range res {
+		if len(c.Currency) != 0 && c.Currency != code {
+			k.l.Warn(k.Ctx, "Cluster prices are in different currencies and can't be converted", "hint", "use $ksctl configure currency to provide the exchange rates")
+			break
+		}
+	}
+
+	return res
+}
+
+func costsCurrency(costs []clusterCost) string {
+	for _, c := range costs {
+		if len(c.Currency) != 0 {
+			return c.Currency
+		}
+	}
+	if d := currency.Default().Display; len(d) != 0 {
+		return d
+	}
+	return "USD"
+}
+
+// budgetCurrency is the currency of the budget limits, the older budgets without one are in the display currency
+func (k *KsctlCommand) budgetCurrency() string {
+	if b := k.KsctlConfig.Budget; b != nil && len(b.Currency) != 0 {
+		return strings.ToUpper(b.Currency)
+	}
+	if c := k.KsctlConfig.Currency; c != nil && len(c.Display) != 0 {
+		return strings.ToUpper(c.Display)
+	}
+	return "USD"
+}
+
+// toBudgetCurrency converts the cost with the exchange rates, an amount without a currency is taken as is
+func (k *KsctlCommand) toBudgetCurrency(v float64, code string) (float64, error) {
+	if len(code) == 0 {
+		return v, nil
+	}
+	return currency.Default().Rates.Convert(v, code, k.budgetCurrency())
+}
+
+func (k *KsctlCommand) budgetAmount(v float64) string {
+	return currency.FormatIn(v, k.budgetCurrency(), currency.Default().Locale)
+}
+
+func budgetMode(e config.BudgetEnforcement) string {
+	if e == config.BudgetBlock {
+		return string(config.BudgetBlock)
+	}
+	return string(config.BudgetWarn)
+}
+
+func isSameCluster(c provider.ClusterData, m controller.Metadata) bool {
+	return c.Name == m.ClusterName &&
+		c.CloudProvider == m.Provider &&
+		c.ClusterType == m.ClusterType &&
+		c.Region == m.Region
+}
+
+const overrideBudgetFlag = "override-budget"
+
+func (k *KsctlCommand) addOverrideBudgetFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&k.overrideBudget, overrideBudgetFlag, false, "Continue even when the blocking budget is exceeded or can't be verified")
+}
+
+// budgetViolations projects the monthly cost of the fleet in the budget currency after adding costDelta
+// to the given cluster, the budget can't be verified when any of the costs can't be estimated
+func (k *KsctlCommand) budgetViolations(m controller.Metadata, costDelta float64, code string) ([]string, error) {
+	b := k.KsctlConfig.Budget
+
+	delta, err := k.toBudgetCurrency(costDelta, code)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := k.fetchAllClusters()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the clusters: %w", err)
+	}
+
+	pricing := k.newInstancePricing()
+	accountTotal, clusterTotal := delta, delta
+	for _, cluster := range clusters {
+		cost, err := pricing.clusterCost(cluster)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate the cost of the cluster %s: %w", cluster.Name, err)
+		}
+		v, err := k.toBudgetCurrency(cost.Total, cost.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert the cost of the cluster %s: %w", cluster.Name, err)
+		}
+		accountTotal += v
+		if isSameCluster(cluster, m) {
+			clusterTotal += v
+		}
+	}
+
+	violations := []string{}
+	if limit := b.LimitForCluster(m.ClusterName); limit > 0 && clusterTotal > limit {
+		violations = append(violations, fmt.Sprintf("cluster %s will cost %s/month which exceeds its budget of %s",
+			m.ClusterName, k.budgetAmount(clusterTotal), k.budgetAmount(limit)))
+	}
+	if b.AccountMonthly > 0 && accountTotal > b.AccountMonthly {
+		violations = append(violations, fmt.Sprintf("all clusters will cost %s/month which exceeds the account budget of %s",
+			k.budgetAmount(accountTotal), k.budgetAmount(b.AccountMonthly)))
+	}
+	k.l.Debug(k.Ctx, "Budget projection", "cluster", clusterTotal, "account", accountTotal, "currency", k.budgetCurrency())
+	return violations, nil
+}
+
+// checkBudget warns about the budget, a blocking budget which is exceeded or can't be verified
+// is an error unless it is overridden
+func (k *KsctlCommand) checkBudget(m controller.Metadata, costDelta float64, code string) error {
+	b := k.KsctlConfig.Budget
+	if !b.IsConfigured() {
+		return nil
+	}
+
+	violations, err := k.budgetViolations(m, costDelta, code)
+	switch {
+	case err != nil && !b.IsBlocking():
+		k.l.Warn(k.Ctx, "Unable to check the budget", "Reason", err)
+		return nil
+	case err != nil && k.overrideBudget:
+		k.l.Warn(k.Ctx, "Overriding the budget which can't be verified", "Reason", err)
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to verify the blocking budget: %w", err)
+	case len(violations) == 0:
+		return nil
+	case !b.IsBlocking():
+		k.l.Box(k.Ctx, "⚠️ Budget Exceeded", strings.Join(violations, "\n"))
+		return nil
+	case k.overrideBudget:
+		k.l.Warn(k.Ctx, "Overriding the exceeded budget", "Reason", strings.Join(violations, "; "))
+		return nil
+	default:
+		return fmt.Errorf("budget exceeded: %s", strings.Join(violations, "; "))
+	}
+}
+
+// enforceBudget exits when the operation adding costDelta to the cluster is blocked by the budget
+func (k *KsctlCommand) enforceBudget(m controller.Metadata, costDelta float64, code string) {
+	if err := k.checkBudget(m, costDelta, code); err != nil {
+		k.l.Error("Operation is blocked by the budget", "Reason", err, "hint", "pass --"+overrideBudgetFlag+" to continue anyway")
+		os.Exit(1)
+	}
+}
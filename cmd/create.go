@@ -15,6 +15,7 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"time"
 
@@ -22,52 +23,117 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
-	"github.com/ksctl/ksctl/v2/pkg/provider"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
 
 	controllerManaged "github.com/ksctl/ksctl/v2/pkg/handler/cluster/managed"
-	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
 	controllerSelfManaged "github.com/ksctl/ksctl/v2/pkg/handler/cluster/selfmanaged"
 	"github.com/spf13/cobra"
 )
 
 func (k *KsctlCommand) Create() *cobra.Command {
+	resume := ""
+	draft := ""
+	preset := ""
+	ttl := ""
+	expires := ""
 
 	cmd := &cobra.Command{
 		Use: "create",
 		Example: `
 ksctl create --help
+ksctl create --draft demo
+ksctl create --resume demo
+ksctl create --resume demo.json
+ksctl create --preset ha
+ksctl create --ttl 8h
+ksctl create --expires 2026-11-01
 		`,
 		Short: "Use to create a cluster",
 		Long:  "It is used to create cluster with the given name from user",
 
 		Run: func(cmd *cobra.Command, args []string) {
+			expiresAt, err := parseExpiry(ttl, expires, time.Now())
+			if err != nil {
+				k.l.Error("Invalid cluster expiry", "Reason", err)
+				os.Exit(1)
+			}
+
 			meta := controller.Metadata{}
 
-			k.baseMetadataFields(&meta)
+			w := k.newCreateWizard(&meta)
+			if len(preset) != 0 {
+				p := new(config.Preset)
+				if err := config.LoadPreset(preset, p); err != nil {
+					k.l.Error("Failed to load the preset", "Reason", err, "hint", "ksctl presets list")
+					os.Exit(1)
+				}
+				w.applyPreset(p)
+			}
+			if len(resume) != 0 && !w.resume(resume) {
+				os.Exit(1)
+			}
+			if len(draft) != 0 && !w.useDraft(draft) {
+				os.Exit(1)
+			}
+
+			if err := w.runWithReview(w.renderBlueprint, "Create the cluster"); err != nil {
+				k.l.Error("Cluster is not created", "Reason", err)
+				os.Exit(1)
+			}
+			w.completeDraft()
 
 			if meta.ClusterType == consts.ClusterTypeMang {
-				k.metadataForManagedCluster(&meta)
+				k.createManagedCluster(meta)
 			} else {
-				k.metadataForSelfManagedCluster(&meta)
+				k.createSelfManagedCluster(meta)
+				k.createWorkerPools(meta, w.workerPools())
 			}
+			k.recordClusterExpiry(meta, expiresAt)
 
 			k.l.Success(k.Ctx, "Created the cluster", "Name", meta.ClusterName)
+			if w.draft != nil {
+				k.l.Note(k.Ctx, "The completed draft is kept to create similar clusters", "export", "ksctl drafts export "+w.draft.Name)
+			}
 		},
 	}
 
+	cmd.Flags().StringVar(&resume, "resume", "", "Continue from the first unanswered step of the draft, or from a cluster spec file")
+	cmd.Flags().StringVar(&draft, "draft", "", "Name of the draft where the answers are saved (default is the cluster name)")
+	cmd.Flags().StringVar(&preset, "preset", "", "Pre-fill the answers from a preset, e.g. dev, ha or cost-optimized (see ksctl presets list)")
+	cmd.Flags().StringVar(&ttl, "ttl", "", "Time after which the cluster expires and can be deleted with ksctl cluster reap, e.g. 8h, 7d or 7d12h")
+	cmd.Flags().StringVar(&expires, "expires", "", "Date when the cluster expires, e.g. 2026-11-01 or \"2026-11-01 18:00:00\"")
+	k.addOverrideBudgetFlag(cmd)
+
 	return cmd
 }
 
+func k8sDistroOf(meta controller.Metadata) consts.KsctlKubernetes {
+	if meta.ClusterType == consts.ClusterTypeSelfMang {
+		return meta.K8sDistro
+	}
+	switch meta.Provider {
+	case consts.CloudLocal:
+		return consts.K8sKind
+	case consts.CloudAws:
+		return consts.K8sEks
+	case consts.CloudAzure:
+		return consts.K8sAks
+	}
+	return ""
+}
+
 type CliRecommendation struct {
 	isOptimizeInstanceRegionReady *optimizer.RecommendationAcrossRegions
 	errInRecommendation           error
+	currency                      string
 }
 
-func (k *KsctlCommand) CostOptimizeAcrossRegion(inp chan CliRecommendation, meta *controller.Metadata) {
+// CostOptimizeAcrossRegion returns the total cost of the recommendation when the region is changed
+func (k *KsctlCommand) CostOptimizeAcrossRegion(inp chan CliRecommendation, meta *controller.Metadata) (float64, bool, error) {
 	ticker := time.NewTicker(2 * time.Second)
 	defer ticker.Stop()
 	for {
@@ -76,179 +142,40 @@ func (k *KsctlCommand) CostOptimizeAcrossRegion(inp chan CliRecommendation, meta
 			optimizeResp, errResp := o.isOptimizeInstanceRegionReady, o.errInRecommendation
 			if errResp != nil {
 				k.l.Warn(k.Ctx, "Failed to get the recommendation", "Reason", errResp)
-				return
+				return 0, false, nil
 			}
 
 			if len(optimizeResp.RegionRecommendations) == 0 {
 				k.l.Success(k.Ctx, "✨ No recommendation available for the selected region")
-				return
+				return 0, false, nil
 			}
 
 			selectedReg, err := k.menuDriven.CardSelection(
-				cli.ConverterForRecommendationIOutputForCards(optimizeResp, meta.ClusterType),
+				cli.ConverterForRecommendationIOutputForCards(optimizeResp, meta.ClusterType, o.currency),
 			)
 			if err != nil {
-				k.l.Error("Failed to get the recommendation options from user", "Reason", err)
-				return
+				return 0, false, fmt.Errorf("failed to get the recommendation options from user: %w", err)
 			}
 
 			if selectedReg != "" {
 				k.l.Print(k.Ctx, "changed the region", "from", color.HiRedString(meta.Region), "to", color.HiGreenString(selectedReg))
 				meta.Region = selectedReg
+
+				for _, r := range optimizeResp.RegionRecommendations {
+					if r.Region.Sku == selectedReg {
+						return r.TotalCost, true, nil
+					}
+				}
 			}
 
-			return
+			return 0, false, nil
 		case <-ticker.C:
 			k.l.Print(k.Ctx, "Still optimizing instance types...")
 		}
 	}
 }
 
-func (k *KsctlCommand) metadataForSelfManagedCluster(meta *controller.Metadata) {
-	metaClient, err := controllerMeta.NewController(
-		k.Ctx,
-		k.l,
-		&controller.Client{
-			Metadata: *meta,
-		},
-	)
-	if err != nil {
-		k.l.Error("Failed to create the controller", "Reason", err)
-		os.Exit(1)
-	}
-
-	allAvailRegions := k.handleRegionSelection(metaClient, meta)
-
-	cp := k.handleInstanceTypeSelection(metaClient, meta, provider.ComputeIntensive, "Select instance_type for Control Plane")
-	etcd := k.handleInstanceTypeSelection(metaClient, meta, provider.MemoryIntensive, "Select instance_type for Etcd Nodes")
-	lb := k.handleInstanceTypeSelection(metaClient, meta, provider.GeneralPurpose, "Select instance_type for Load Balancer")
-
-	category := provider.Unknown
-	if meta.Provider != consts.CloudLocal {
-		category = k.handleInstanceCategorySelection()
-	}
-
-	wp := k.handleInstanceTypeSelection(metaClient, meta, category, "Select instance_type for Worker Nodes")
-
-	meta.ControlPlaneNodeType = cp.Sku
-	meta.WorkerPlaneNodeType = wp.Sku
-	meta.DataStoreNodeType = etcd.Sku
-	meta.LoadBalancerNodeType = lb.Sku
-
-	if v, ok := k.getCounterValue("Enter the number of Control Plane Nodes", func(v int) bool {
-		return v >= 3
-	}, 3); !ok {
-		k.l.Error("Failed to get the number of control plane nodes")
-		os.Exit(1)
-	} else {
-		meta.NoCP = v
-	}
-
-	if v, ok := k.getCounterValue("Enter the number of Worker Nodes", func(v int) bool {
-		return v > 0
-	}, 1); !ok {
-		k.l.Error("Failed to get the number of worker nodes")
-		os.Exit(1)
-	} else {
-		meta.NoWP = v
-	}
-
-	if v, ok := k.getCounterValue("Enter the number of Etcd Nodes", func(v int) bool {
-		return v >= 3
-	}, 3); !ok {
-		k.l.Error("Failed to get the number of etcd nodes")
-		os.Exit(1)
-	} else {
-		meta.NoDS = v
-	}
-
-	var (
-		isOptimizeInstanceRegionReady chan CliRecommendation
-	)
-	isOptimizeInstanceRegionReady = make(chan CliRecommendation)
-
-	go func() {
-		res, err := metaClient.CostOptimizeAcrossRegions(
-			allAvailRegions, meta.Region,
-			controllerMeta.CostOptimizerInput{
-				ControlPlane:             cp,
-				WorkerPlane:              wp,
-				DataStorePlane:           etcd,
-				LoadBalancer:             lb,
-				CountOfControlPlaneNodes: meta.NoCP,
-				CountOfWorkerNodes:       meta.NoWP,
-				CountOfEtcdNodes:         meta.NoDS,
-			},
-		)
-
-		isOptimizeInstanceRegionReady <- CliRecommendation{
-			isOptimizeInstanceRegionReady: res,
-			errInRecommendation:           err,
-		}
-	}()
-
-	bootstrapVers, err := metaClient.ListAllBootstrapVersions()
-	if err != nil {
-		k.l.Error("Failed to get the list of bootstrap versions", "Reason", err)
-		os.Exit(1)
-	}
-
-	if v, err := k.menuDriven.DropDownList("Select the bootstrap version", bootstrapVers, cli.WithDefaultValue(bootstrapVers[0])); err != nil {
-		k.l.Error("Failed to get the bootstrap version", "Reason", err)
-		os.Exit(1)
-	} else {
-		k.l.Debug(k.Ctx, "Selected bootstrap version", "Version", v)
-		meta.K8sVersion = v
-	}
-
-	etcdVers, err := metaClient.ListAllEtcdVersions()
-	if err != nil {
-		k.l.Error("Failed to get the list of etcd versions", "Reason", err)
-		os.Exit(1)
-	}
-	if v, err := k.menuDriven.DropDownList("Select the etcd version", etcdVers, cli.WithDefaultValue(etcdVers[0])); err != nil {
-		k.l.Error("Failed to get the etcd version", "Reason", err)
-		os.Exit(1)
-	} else {
-		k.l.Debug(k.Ctx, "Selected etcd version", "Version", v)
-		meta.EtcdVersion = v
-	}
-
-	k.l.Print(k.Ctx, "Current Selection will cost you")
-	_, err = metaClient.PriceCalculator(
-		controllerMeta.PriceCalculatorInput{
-			Currency:              cp.Price.Currency,
-			NoOfWorkerNodes:       meta.NoWP,
-			NoOfControlPlaneNodes: meta.NoCP,
-			NoOfEtcdNodes:         meta.NoDS,
-			ControlPlaneMachine:   cp,
-			WorkerMachine:         wp,
-			EtcdMachine:           etcd,
-			LoadBalancerMachine:   lb,
-		})
-	if err != nil {
-		k.l.Error("Failed to calculate the price", "Reason", err)
-		os.Exit(1)
-	}
-
-	k.CostOptimizeAcrossRegion(isOptimizeInstanceRegionReady, meta)
-
-	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := metaClient.ListBootstrapCNIs()
-	if err != nil {
-		k.l.Error("Failed to get the list of self managed CNIs", "Reason", err)
-		os.Exit(1)
-	}
-
-	v, err := k.handleCNI(metaClient, managedCNI, defaultCNI, ksctlCNI, defaultKsctl)
-	if err != nil {
-		k.l.Error("Failed to get the CNI", "Reason", err)
-		os.Exit(1)
-	}
-
-	meta.Addons = v
-
-	k.metadataSummary(*meta)
-
+func (k *KsctlCommand) createSelfManagedCluster(meta controller.Metadata) {
 	if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterCreate, telemetry.TelemetryMeta{
 		CloudProvider:     meta.Provider,
 		StorageDriver:     meta.StateLocation,
@@ -261,15 +188,11 @@ func (k *KsctlCommand) metadataForSelfManagedCluster(meta *controller.Metadata)
 		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
 	}
 
-	if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with the cluster creation", cli.WithDefaultValue("no")); !ok {
-		os.Exit(1)
-	}
-
 	c, err := controllerSelfManaged.NewController(
 		k.Ctx,
 		k.l,
 		&controller.Client{
-			Metadata: *meta,
+			Metadata: meta,
 		},
 	)
 	if err != nil {
@@ -282,147 +205,27 @@ func (k *KsctlCommand) metadataForSelfManagedCluster(meta *controller.Metadata)
 		os.Exit(1)
 	}
 
-	return
+	k.recordClusterCreated(meta)
 }
 
-func (k *KsctlCommand) metadataForManagedCluster(meta *controller.Metadata) {
-	metaClient, err := controllerMeta.NewController(
-		k.Ctx,
-		k.l,
-		&controller.Client{
-			Metadata: *meta,
-		},
-	)
-	if err != nil {
-		k.l.Error("Failed to create the controller", "Reason", err)
-		os.Exit(1)
-	}
-
-	if v, ok := k.getCounterValue("Enter the number of Managed Nodes", func(v int) bool {
-		return v > 0
-	}, 1); !ok {
-		k.l.Error("Failed to get the number of managed nodes")
-		os.Exit(1)
-	} else {
-		meta.NoMP = v
-	}
-
-	var (
-		isOptimizeInstanceRegionReady chan CliRecommendation
-	)
-
-	if meta.Provider != consts.CloudLocal {
-		allAvailRegions := k.handleRegionSelection(metaClient, meta)
-
-		category := provider.Unknown
-		if meta.Provider != consts.CloudLocal {
-			category = k.handleInstanceCategorySelection()
-		}
-
-		vm := k.handleInstanceTypeSelection(metaClient, meta, category, "Select instance_type for Managed Nodes")
-		meta.ManagedNodeType = vm.Sku
-
-		k.menuDriven.GetProgressAnimation().Start("Fetching the managed cluster offerings")
-
-		listOfOfferings, err := metaClient.ListAllManagedClusterManagementOfferings(meta.Region, nil)
-		if err != nil {
-			k.menuDriven.GetProgressAnimation().Stop()
-			k.l.Error("Failed to sync the metadata", "Reason", err)
-			os.Exit(1)
-		}
-		k.menuDriven.GetProgressAnimation().Stop()
-
-		offeringSelected := ""
-
-		if v, ok := k.getSelectedManagedClusterOffering("Select the managed cluster offering", listOfOfferings); !ok {
-			k.l.Error("Failed to get the managed cluster offering")
-			os.Exit(1)
-		} else {
-			offeringSelected = v
-		}
-
-		isOptimizeInstanceRegionReady = make(chan CliRecommendation)
-
-		go func() {
-			res, err := metaClient.CostOptimizeAcrossRegions(
-				allAvailRegions, meta.Region,
-				controllerMeta.CostOptimizerInput{
-					ManagedOffering:     listOfOfferings[offeringSelected],
-					ManagedPlane:        vm,
-					CountOfManagedNodes: meta.NoMP,
-				},
-			)
-			isOptimizeInstanceRegionReady <- CliRecommendation{
-				isOptimizeInstanceRegionReady: res,
-				errInRecommendation:           err,
-			}
-		}()
-
-		k.l.Print(k.Ctx, "Current Selection will cost you")
-
-		_, err = metaClient.PriceCalculator(
-			controllerMeta.PriceCalculatorInput{
-				ManagedControlPlaneMachine: listOfOfferings[offeringSelected],
-				NoOfWorkerNodes:            meta.NoMP,
-				WorkerMachine:              vm,
-			})
-		if err != nil {
-			k.l.Error("Failed to calculate the price", "Reason", err)
-			os.Exit(1)
-		}
-
-		k.CostOptimizeAcrossRegion(isOptimizeInstanceRegionReady, meta)
-	}
-
-	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := metaClient.ListManagedCNIs()
-	if err != nil {
-		k.l.Error("Failed to get the list of managed CNIs", "Reason", err)
-		os.Exit(1)
-	}
-
-	if v, err := k.handleCNI(metaClient, managedCNI, defaultCNI, ksctlCNI, defaultKsctl); err != nil {
-		k.l.Error("Failed to get the CNI", "Reason", err)
-		os.Exit(1)
-	} else {
-		meta.Addons = v
-	}
-
-	k.handleManagedK8sVersion(metaClient, meta)
-
-	k.metadataSummary(*meta)
-
+func (k *KsctlCommand) createManagedCluster(meta controller.Metadata) {
 	if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterCreate, telemetry.TelemetryMeta{
-		CloudProvider: meta.Provider,
-		StorageDriver: meta.StateLocation,
-		Region:        meta.Region,
-		ClusterType:   meta.ClusterType,
-		BootstrapProvider: func() consts.KsctlKubernetes {
-			switch meta.Provider {
-			case consts.CloudLocal:
-				return consts.K8sKind
-			case consts.CloudAzure:
-				return consts.K8sAks
-			case consts.CloudAws:
-				return consts.K8sEks
-			default:
-				return ""
-			}
-		}(),
-		K8sVersion: meta.K8sVersion,
-		Addons:     telemetry.TranslateMetadata(meta.Addons),
+		CloudProvider:     meta.Provider,
+		StorageDriver:     meta.StateLocation,
+		Region:            meta.Region,
+		ClusterType:       meta.ClusterType,
+		BootstrapProvider: k8sDistroOf(meta),
+		K8sVersion:        meta.K8sVersion,
+		Addons:            telemetry.TranslateMetadata(meta.Addons),
 	}); err != nil {
 		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
 	}
 
-	if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with the cluster creation", cli.WithDefaultValue("no")); !ok {
-		os.Exit(1)
-	}
-
 	c, err := controllerManaged.NewController(
 		k.Ctx,
 		k.l,
 		&controller.Client{
-			Metadata: *meta,
+			Metadata: meta,
 		},
 	)
 	if err != nil {
@@ -435,5 +238,5 @@ func (k *KsctlCommand) metadataForManagedCluster(meta *controller.Metadata) {
 		os.Exit(1)
 	}
 
-	return
+	k.recordClusterCreated(meta)
 }
@@ -0,0 +1,600 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/addons"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+const (
+	stepClusterName      = "clusterName"
+	stepClusterType      = "clusterType"
+	stepCloudProvider    = "cloudProvider"
+	stepStorageDriver    = "storageDriver"
+	stepBootstrap        = "bootstrap"
+	stepMetadataClient   = "metadataClient"
+	stepRegion           = "region"
+	stepArch             = "arch"
+	stepControlPlane     = "controlPlane"
+	stepDataStore        = "dataStore"
+	stepLoadBalancer     = "loadBalancer"
+	stepWorkerPlane      = "workerPlane"
+	stepManagedNodes     = "managedNodes"
+	stepManagedOffering  = "managedOffering"
+	stepNoCP             = "noCP"
+	stepNoWP             = "noWP"
+	stepNoDS             = "noDS"
+	stepWorkerPools      = "workerPools"
+	stepNoMP             = "noMP"
+	stepRightSizing      = "rightSizing"
+	stepCostOptimizer    = "costOptimizer"
+	stepBootstrapVersion = "bootstrapVersion"
+	stepEtcdVersion      = "etcdVersion"
+	stepPrice            = "price"
+	stepCNI              = "cni"
+	stepManagedVersion   = "managedVersion"
+)
+
+// createWizard holds the answers of the cluster create wizard which are not part of the metadata
+type createWizard struct {
+	*wizard
+	meta       *controller.Metadata
+	metaClient *controllerMeta.Controller
+
+	regions provider.RegionsOutput
+	archs   map[string]provider.MachineArch
+
+	cp, etcd, lb, wp provider.InstanceRegionOutput
+	defaultWP        int
+
+	// pools are the worker pools, the first one is the default pool of the worker plane nodes
+	pools   []config.WorkerPool
+	poolVMs map[string]provider.InstanceRegionOutput
+
+	vm        provider.InstanceRegionOutput
+	defaultMP int
+	offering  provider.ManagedClusterOutput
+
+	optimizer chan CliRecommendation
+
+	// preset answers the steps it covers, nil when the wizard asks everything
+	preset *config.Preset
+
+	// draft is where the answers are saved, it is named after the cluster unless set
+	draft          *config.Draft
+	draftAnnounced bool
+}
+
+func (k *KsctlCommand) newCreateWizard(meta *controller.Metadata) *createWizard {
+	w := &createWizard{
+		wizard: &wizard{
+			k:        k,
+			answered: map[string]bool{},
+		},
+		meta:      meta,
+		defaultWP: 1,
+		defaultMP: 1,
+	}
+	w.onAnswered = w.saveDraft
+	w.canProceed = func() (bool, error) {
+		return k.confirmArchSupport(*meta, w.nodeArchs())
+	}
+
+	selfManaged := func() bool { return meta.ClusterType == consts.ClusterTypeSelfMang }
+	managed := func() bool { return meta.ClusterType == consts.ClusterTypeMang }
+	local := func() bool { return meta.Provider == consts.CloudLocal }
+
+	notSelfManaged := func() bool { return !selfManaged() }
+	notManaged := func() bool { return !managed() }
+	notManagedCloud := func() bool { return !managed() || local() }
+
+	w.steps = []wizardStep{
+		{name: stepClusterName, title: "Name", interactive: true, run: w.askClusterName},
+		{name: stepClusterType, interactive: true, run: w.askClusterType},
+		{name: stepCloudProvider, dependsOn: []string{stepClusterType}, interactive: true, run: w.askCloudProvider, resume: w.loadCloudProviderCreds},
+		{name: stepStorageDriver, run: w.askStorageDriver},
+		{name: stepBootstrap, title: "Bootstrap Provider", dependsOn: []string{stepClusterType}, interactive: true, skip: notSelfManaged, run: w.askBootstrap},
+		{name: stepMetadataClient, dependsOn: []string{stepCloudProvider, stepBootstrap}, run: w.newMetadataClient},
+		{name: stepRegion, title: "Region", dependsOn: []string{stepCloudProvider}, interactive: true, skip: local, run: w.askRegion, resume: w.fetchRegions},
+		{name: stepArch, title: "CPU Architecture", dependsOn: []string{stepCloudProvider}, interactive: true, skip: local, run: w.askArch},
+
+		{name: stepControlPlane, title: "Control Plane instance type", dependsOn: []string{stepRegion, stepArch}, interactive: true, skip: notSelfManaged, run: w.askControlPlane},
+		{name: stepDataStore, title: "Etcd Nodes instance type", dependsOn: []string{stepRegion, stepArch}, interactive: true, skip: notSelfManaged, run: w.askDataStore},
+		{name: stepLoadBalancer, title: "Load Balancer instance type", dependsOn: []string{stepRegion, stepArch}, interactive: true, skip: notSelfManaged, run: w.askLoadBalancer},
+		{name: stepWorkerPlane, title: "Worker Nodes instance type", dependsOn: []string{stepRegion, stepArch}, interactive: true, skip: notSelfManaged, run: w.askWorkerPlane},
+		{name: stepNoCP, title: "Control Plane nodes count", dependsOn: []string{stepClusterType}, interactive: true, skip: notSelfManaged, run: w.askNoCP},
+		{name: stepNoWP, title: "Worker Nodes count", dependsOn: []string{stepWorkerPlane}, interactive: true, skip: notSelfManaged, run: w.askNoWP},
+		{name: stepNoDS, title: "Etcd Nodes count", dependsOn: []string{stepClusterType}, interactive: true, skip: notSelfManaged, run: w.askNoDS},
+		{name: stepWorkerPools, title: "Worker Pools", dependsOn: []string{stepWorkerPlane}, interactive: true, skip: notSelfManaged, run: w.askWorkerPools},
+
+		{name: stepManagedNodes, title: "Managed Nodes instance type", dependsOn: []string{stepRegion, stepArch}, interactive: true, skip: notManagedCloud, run: w.askManagedNodes},
+		{name: stepManagedOffering, title: "Managed Offering", dependsOn: []string{stepRegion}, interactive: true, skip: notManagedCloud, run: w.askManagedOffering},
+		{name: stepNoMP, title: "Managed Nodes count", dependsOn: []string{stepManagedNodes}, interactive: true, skip: notManaged, run: w.askNoMP},
+
+		{name: stepRightSizing, dependsOn: []string{stepControlPlane, stepDataStore, stepLoadBalancer, stepWorkerPlane, stepManagedNodes, stepNoCP, stepNoWP, stepNoDS, stepNoMP}, interactive: true, skip: local, run: w.rightSizing},
+		{name: stepCostOptimizer, dependsOn: []string{stepRightSizing, stepManagedOffering}, skip: local, run: w.startCostOptimizer},
+
+		{name: stepBootstrapVersion, title: "Kubernetes Version", dependsOn: []string{stepMetadataClient}, interactive: true, skip: notSelfManaged, run: w.askBootstrapVersion},
+		{name: stepEtcdVersion, title: "Etcd Version", dependsOn: []string{stepMetadataClient}, interactive: true, skip: notSelfManaged, run: w.askEtcdVersion},
+
+		{name: stepPrice, dependsOn: []string{stepCostOptimizer, stepWorkerPools}, interactive: true, skip: local, run: w.priceAndRegionRecommendation},
+		{name: stepCNI, title: "CNI", dependsOn: []string{stepMetadataClient}, interactive: true, run: w.askCNI},
+		{name: stepManagedVersion, title: "Kubernetes Version", dependsOn: []string{stepRegion, stepPrice}, interactive: true, skip: notManaged, run: w.askManagedVersion},
+	}
+
+	return w
+}
+
+func (w *createWizard) askClusterName() error {
+	v, err := w.k.getClusterName()
+	if err != nil {
+		return err
+	}
+	w.meta.ClusterName = v
+	return nil
+}
+
+func (w *createWizard) askClusterType() error {
+	v, err := w.k.getSelectedClusterType()
+	if err != nil {
+		return err
+	}
+	w.meta.ClusterType = v
+	return nil
+}
+
+func (w *createWizard) askCloudProvider() error {
+	v, err := w.k.getSelectedCloudProvider(w.meta.ClusterType)
+	if err != nil {
+		return err
+	}
+	w.meta.Provider = v
+	return nil
+}
+
+func (w *createWizard) loadCloudProviderCreds() error {
+	return w.k.loadCloudProviderCreds(w.meta.Provider)
+}
+
+func (w *createWizard) askStorageDriver() error {
+	v, err := w.k.getSelectedStorageDriver()
+	if err != nil {
+		return err
+	}
+	w.meta.StateLocation = v
+	return nil
+}
+
+func (w *createWizard) askBootstrap() error {
+	v, err := w.k.getBootstrap()
+	if err != nil {
+		return err
+	}
+	w.meta.K8sDistro = v
+	return nil
+}
+
+func (w *createWizard) newMetadataClient() error {
+	metaClient, err := controllerMeta.NewController(
+		w.k.Ctx,
+		w.k.l,
+		&controller.Client{
+			Metadata: *w.meta,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create the controller: %w", err)
+	}
+	w.metaClient = metaClient
+	w.k.inMemInstanceTypesInReg = nil
+	return nil
+}
+
+func (w *createWizard) askRegion() error {
+	regions, err := w.k.handleRegionSelection(w.metaClient, w.meta)
+	if err != nil {
+		return err
+	}
+	w.regions = regions
+	// the instance types and their prices are specific to the region
+	w.k.inMemInstanceTypesInReg = nil
+	return nil
+}
+
+func (w *createWizard) fetchRegions() error {
+	regions, err := w.k.fetchRegions(w.metaClient)
+	if err != nil {
+		return err
+	}
+	w.regions = regions
+	return nil
+}
+
+func (w *createWizard) askArch() error {
+	roles := []string{roleManagedNodes}
+	if w.meta.ClusterType == consts.ClusterTypeSelfMang {
+		roles = []string{roleControlPlane, roleDataStore, roleLoadBalancer, roleWorkerPlane}
+	}
+
+	archs, err := w.k.handleArchSelection(w.meta, roles...)
+	if err != nil {
+		return err
+	}
+	w.archs = archs
+	return nil
+}
+
+func (w *createWizard) askControlPlane() error {
+	vm, err := w.k.handleInstanceTypeSelection(w.metaClient, w.meta, provider.ComputeIntensive, w.archs[roleControlPlane], "Select instance_type for Control Plane")
+	if err != nil {
+		return err
+	}
+	w.cp = vm
+	w.meta.ControlPlaneNodeType = w.cp.Sku
+	return nil
+}
+
+func (w *createWizard) askDataStore() error {
+	vm, err := w.k.handleInstanceTypeSelection(w.metaClient, w.meta, provider.MemoryIntensive, w.archs[roleDataStore], "Select instance_type for Etcd Nodes")
+	if err != nil {
+		return err
+	}
+	w.etcd = vm
+	w.meta.DataStoreNodeType = w.etcd.Sku
+	return nil
+}
+
+func (w *createWizard) askLoadBalancer() error {
+	vm, err := w.k.handleInstanceTypeSelection(w.metaClient, w.meta, provider.GeneralPurpose, w.archs[roleLoadBalancer], "Select instance_type for Load Balancer")
+	if err != nil {
+		return err
+	}
+	w.lb = vm
+	w.meta.LoadBalancerNodeType = w.lb.Sku
+	return nil
+}
+
+// askWorkers picks the instance type from the expected workload or manually,
+// it returns the recommended number of nodes
+func (w *createWizard) askWorkers(role, prompt string) (provider.InstanceRegionOutput, int, error) {
+	rec, ok, err := w.k.handleWorkloadRightSizing(w.metaClient, w.meta, w.archs[role])
+	if err != nil {
+		return provider.InstanceRegionOutput{}, 0, err
+	}
+	if ok {
+		return rec.Instance, rec.Count, nil
+	}
+
+	category := provider.Unknown
+	if w.meta.Provider != consts.CloudLocal {
+		if category, err = w.k.handleInstanceCategorySelection(); err != nil {
+			return provider.InstanceRegionOutput{}, 0, err
+		}
+	}
+
+	vm, err := w.k.handleInstanceTypeSelection(w.metaClient, w.meta, category, w.archs[role], prompt)
+	return vm, 1, err
+}
+
+func (w *createWizard) askWorkerPlane() error {
+	vm, count, err := w.askWorkers(roleWorkerPlane, "Select instance_type for Worker Nodes")
+	if err != nil {
+		return err
+	}
+	w.wp, w.defaultWP = vm, count
+	w.meta.WorkerPlaneNodeType = w.wp.Sku
+	return nil
+}
+
+func (w *createWizard) askManagedNodes() error {
+	vm, count, err := w.askWorkers(roleManagedNodes, "Select instance_type for Managed Nodes")
+	if err != nil {
+		return err
+	}
+	w.vm, w.defaultMP = vm, count
+	w.meta.ManagedNodeType = w.vm.Sku
+	return nil
+}
+
+// listManagedOfferings returns the managed cluster offerings of the region
+func (w *createWizard) listManagedOfferings() (map[string]provider.ManagedClusterOutput, error) {
+	ss := w.k.menuDriven.GetProgressAnimation()
+	ss.Start("Fetching the managed cluster offerings")
+
+	offerings, err := w.metaClient.ListAllManagedClusterManagementOfferings(w.meta.Region, nil)
+	ss.Stop()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync the metadata: %w", err)
+	}
+	return offerings, nil
+}
+
+func (w *createWizard) askManagedOffering() error {
+	listOfOfferings, err := w.listManagedOfferings()
+	if err != nil {
+		return err
+	}
+
+	v, err := w.k.getSelectedManagedClusterOffering("Select the managed cluster offering", listOfOfferings)
+	if err != nil {
+		return fmt.Errorf("failed to get the managed cluster offering: %w", err)
+	}
+	w.offering = listOfOfferings[v]
+	return nil
+}
+
+func (w *createWizard) askCount(prompt string, validate userInputValidation, defaultVal int, what string) (int, error) {
+	v, err := w.k.getCounterValue(prompt, validate, defaultVal)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get the number of %s: %w", what, err)
+	}
+	return v, nil
+}
+
+func (w *createWizard) askNoCP() (err error) {
+	w.meta.NoCP, err = w.askCount("Enter the number of Control Plane Nodes", oddQuorum(3), 3, "control plane nodes")
+	return err
+}
+
+func (w *createWizard) askNoWP() (err error) {
+	w.meta.NoWP, err = w.askCount("Enter the number of Worker Nodes", inRange(1, maxNodesPerPool), w.defaultWP, "worker nodes")
+	return err
+}
+
+func (w *createWizard) askNoDS() (err error) {
+	w.meta.NoDS, err = w.askCount("Enter the number of Etcd Nodes", oddQuorum(3), 3, "etcd nodes")
+	return err
+}
+
+func (w *createWizard) askNoMP() (err error) {
+	w.meta.NoMP, err = w.askCount("Enter the number of Managed Nodes", inRange(1, maxNodesPerPool), w.defaultMP, "managed nodes")
+	return err
+}
+
+func (w *createWizard) rightSizing() error {
+	if w.meta.ClusterType == consts.ClusterTypeSelfMang {
+		if err := w.k.handleInstanceRightSizing(w.metaClient, w.meta,
+			roleInstance{roleControlPlane, &w.cp},
+			roleInstance{roleWorkerPlane, &w.wp},
+			roleInstance{roleDataStore, &w.etcd},
+			roleInstance{roleLoadBalancer, &w.lb},
+		); err != nil {
+			return err
+		}
+		w.meta.ControlPlaneNodeType = w.cp.Sku
+		w.meta.WorkerPlaneNodeType = w.wp.Sku
+		w.meta.DataStoreNodeType = w.etcd.Sku
+		w.meta.LoadBalancerNodeType = w.lb.Sku
+		return nil
+	}
+
+	if err := w.k.handleInstanceRightSizing(w.metaClient, w.meta, roleInstance{roleManagedNodes, &w.vm}); err != nil {
+		return err
+	}
+	w.meta.ManagedNodeType = w.vm.Sku
+	return nil
+}
+
+// startCostOptimizer looks for cheaper regions in the background while the next questions are asked
+func (w *createWizard) startCostOptimizer() error {
+	// buffered so that the search doesn't block when the user goes back before its result is used
+	ch := make(chan CliRecommendation, 1)
+	w.optimizer = ch
+
+	meta := *w.meta
+	input := controllerMeta.CostOptimizerInput{
+		ControlPlane:             w.cp,
+		WorkerPlane:              w.wp,
+		DataStorePlane:           w.etcd,
+		LoadBalancer:             w.lb,
+		CountOfControlPlaneNodes: meta.NoCP,
+		CountOfWorkerNodes:       meta.NoWP,
+		CountOfEtcdNodes:         meta.NoDS,
+	}
+	costCurrency := w.cp.Price.Currency
+	if meta.ClusterType == consts.ClusterTypeMang {
+		input = controllerMeta.CostOptimizerInput{
+			ManagedOffering:     w.offering,
+			ManagedPlane:        w.vm,
+			CountOfManagedNodes: meta.NoMP,
+		}
+		costCurrency = w.vm.Price.Currency
+	}
+
+	go func() {
+		res, err := w.metaClient.CostOptimizeAcrossRegions(w.regions, meta.Region, input)
+		ch <- CliRecommendation{
+			isOptimizeInstanceRegionReady: res,
+			errInRecommendation:           err,
+			currency:                      costCurrency,
+		}
+	}()
+	return nil
+}
+
+func (w *createWizard) askBootstrapVersion() error {
+	bootstrapVers, err := w.metaClient.ListAllBootstrapVersions()
+	if err != nil {
+		return fmt.Errorf("failed to get the list of bootstrap versions: %w", err)
+	}
+
+	v, err := w.k.menuDriven.DropDownList("Select the bootstrap version", bootstrapVers, cli.WithDefaultValue(bootstrapVers[0]))
+	if err != nil {
+		return fmt.Errorf("failed to get the bootstrap version: %w", err)
+	}
+	w.k.l.Debug(w.k.Ctx, "Selected bootstrap version", "Version", v)
+	w.meta.K8sVersion = v
+	return nil
+}
+
+func (w *createWizard) askEtcdVersion() error {
+	etcdVers, err := w.metaClient.ListAllEtcdVersions()
+	if err != nil {
+		return fmt.Errorf("failed to get the list of etcd versions: %w", err)
+	}
+
+	v, err := w.k.menuDriven.DropDownList("Select the etcd version", etcdVers, cli.WithDefaultValue(etcdVers[0]))
+	if err != nil {
+		return fmt.Errorf("failed to get the etcd version: %w", err)
+	}
+	w.k.l.Debug(w.k.Ctx, "Selected etcd version", "Version", v)
+	w.meta.EtcdVersion = v
+	return nil
+}
+
+// monthlyCost is the price of the current selection
+func (w *createWizard) monthlyCost() (float64, string, error) {
+	if w.meta.Provider == consts.CloudLocal {
+		return 0, "", nil
+	}
+
+	in := controllerMeta.PriceCalculatorInput{
+		Currency:              w.cp.Price.Currency,
+		NoOfWorkerNodes:       w.meta.NoWP,
+		NoOfControlPlaneNodes: w.meta.NoCP,
+		NoOfEtcdNodes:         w.meta.NoDS,
+		ControlPlaneMachine:   w.cp,
+		WorkerMachine:         w.wp,
+		EtcdMachine:           w.etcd,
+		LoadBalancerMachine:   w.lb,
+	}
+	if w.meta.ClusterType == consts.ClusterTypeMang {
+		in = controllerMeta.PriceCalculatorInput{
+			ManagedControlPlaneMachine: w.offering,
+			NoOfWorkerNodes:            w.meta.NoMP,
+			WorkerMachine:              w.vm,
+		}
+	}
+
+	totalCost, err := w.metaClient.PriceCalculator(in)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to calculate the price: %w", err)
+	}
+
+	if w.meta.ClusterType == consts.ClusterTypeMang {
+		return totalCost, w.vm.Price.Currency, nil
+	}
+	return totalCost + w.workerPoolsCost(), w.cp.Price.Currency, nil
+}
+
+// checkBudget fails when the blocking budget doesn't allow the current selection
+func (w *createWizard) checkBudget() error {
+	totalCost, costCurrency, err := w.monthlyCost()
+	if err != nil {
+		return err
+	}
+	if err := w.k.checkBudget(*w.meta, totalCost, costCurrency); err != nil {
+		return fmt.Errorf("%w, pass --%s to continue anyway", err, overrideBudgetFlag)
+	}
+	return nil
+}
+
+func (w *createWizard) priceAndRegionRecommendation() error {
+	if w.optimizer == nil {
+		// the result of the previous search was used before the user came back to this step
+		if err := w.startCostOptimizer(); err != nil {
+			return err
+		}
+	}
+
+	w.k.l.Print(w.k.Ctx, "Current Selection will cost you")
+	if err := w.checkBudget(); err != nil {
+		return err
+	}
+
+	optimizer := w.optimizer
+	w.optimizer = nil
+	_, ok, err := w.k.CostOptimizeAcrossRegion(optimizer, w.meta)
+	if err != nil {
+		return err
+	}
+	if ok {
+		// the instance types and their prices are specific to the region
+		w.k.inMemInstanceTypesInReg = nil
+	}
+	return nil
+}
+
+// listCNIs returns the cnis of the offering and the ones provided by ksctl along with their defaults
+func (w *createWizard) listCNIs() (addons.ClusterAddons, string, addons.ClusterAddons, string, error) {
+	var listCNIs = w.metaClient.ListBootstrapCNIs
+	if w.meta.ClusterType == consts.ClusterTypeMang {
+		listCNIs = w.metaClient.ListManagedCNIs
+	}
+
+	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := listCNIs()
+	if err != nil {
+		return nil, "", nil, "", fmt.Errorf("failed to get the list of CNIs: %w", err)
+	}
+	return managedCNI, defaultCNI, ksctlCNI, defaultKsctl, nil
+}
+
+func (w *createWizard) askCNI() error {
+	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := w.listCNIs()
+	if err != nil {
+		return err
+	}
+
+	v, err := w.k.handleCNI(w.metaClient, managedCNI, defaultCNI, ksctlCNI, defaultKsctl)
+	if err != nil {
+		return err
+	}
+
+	w.meta.Addons = v
+	return nil
+}
+
+func (w *createWizard) askManagedVersion() error {
+	return w.k.handleManagedK8sVersion(w.metaClient, w.meta)
+}
+
+// nodeArchs returns the cpu architecture of the nodes of every role
+func (w *createWizard) nodeArchs() map[string]provider.MachineArch {
+	if w.meta.ClusterType == consts.ClusterTypeMang {
+		return map[string]provider.MachineArch{roleManagedNodes: w.vm.CpuArch}
+	}
+
+	res := map[string]provider.MachineArch{
+		roleControlPlane: w.cp.CpuArch,
+		roleWorkerPlane:  w.wp.CpuArch,
+		roleDataStore:    w.etcd.CpuArch,
+	}
+	// any worker pool which is not amd64 needs the releases for its architecture
+	for _, vm := range w.poolVMs {
+		if len(vm.CpuArch) != 0 && vm.CpuArch != provider.ArchAmd64 {
+			res[roleWorkerPlane] = vm.CpuArch
+		}
+	}
+	return res
+}
+
+// renderBlueprint shows the blueprint with the recomputed price
+func (w *createWizard) renderBlueprint() error {
+	totalCost, costCurrency, err := w.monthlyCost()
+	if err != nil {
+		return err
+	}
+
+	w.k.metadataSummary(*w.meta, w.workerPools(), totalCost, costCurrency)
+	return nil
+}
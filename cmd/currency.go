@@ -0,0 +1,77 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/currency"
+)
+
+func envLocale() string {
+	for _, env := range []string{"LC_ALL", "LC_MONETARY", "LANG"} {
+		if v := os.Getenv(env); len(v) != 0 {
+			return currency.ParseLocale(v)
+		}
+	}
+	return currency.DefaultLocale
+}
+
+// setupCurrency configures the converter used to display every price from the currency preference
+func (k *KsctlCommand) setupCurrency() {
+	conv := &currency.Converter{Locale: envLocale()}
+
+	pref := k.KsctlConfig.Currency
+	if pref == nil {
+		pref = new(config.Currency)
+	}
+
+	conv.Display = strings.ToUpper(pref.Display)
+	if len(pref.Locale) != 0 {
+		conv.Locale = pref.Locale
+	}
+
+	// the budget limits in their own currency are converted with the same rates
+	if b := k.KsctlConfig.Budget; len(conv.Display) != 0 || (b.IsConfigured() && len(b.Currency) != 0) {
+		rates, err := config.LoadRatesCache()
+		if err != nil {
+			k.l.Warn(k.Ctx, "Failed to load the cached exchange rates", "Reason", err)
+		}
+
+		// the rates file is re-imported whenever it is newer than the cache
+		if len(pref.RatesFile) != 0 {
+			if r, err := config.ReadRatesFile(pref.RatesFile); err != nil {
+				k.l.Warn(k.Ctx, "Failed to read the exchange rates file", "Reason", err)
+			} else if rates == nil || r.UpdatedAt.After(rates.UpdatedAt) {
+				rates = r
+				if err := config.SaveRatesCache(r); err != nil {
+					k.l.Debug(k.Ctx, "Failed to cache the exchange rates", "Reason", err)
+				}
+			}
+		}
+
+		if rates == nil {
+			k.l.Warn(k.Ctx, "No exchange rates available, prices are shown in the catalog currency", "hint", "use $ksctl configure currency")
+		} else if rates.IsStale(time.Now(), config.RatesMaxAge) {
+			k.l.Warn(k.Ctx, "Exchange rates are outdated", "updatedAt", rates.UpdatedAt.Local().Format(time.DateOnly), "source", rates.Source)
+		}
+		conv.Rates = rates
+	}
+
+	currency.SetDefault(conv)
+}
@@ -17,10 +17,8 @@ package cmd
 import (
 	"fmt"
 	"os"
-	"strconv"
 	"strings"
 
-	"github.com/ksctl/cli/v2/pkg/cli"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
@@ -31,14 +29,18 @@ import (
 )
 
 func (k *KsctlCommand) Delete() *cobra.Command {
+	override := false
+	bulk := bulkOptions{}
 
 	cmd := &cobra.Command{
 		Use: "delete",
 		Example: `
 ksctl delete --help
+ksctl delete --override-protection
+ksctl delete --selector env=dev --concurrency 2
 		`,
 		Short: "Use to delete a cluster",
-		Long:  "It is used to delete cluster with the given name from user",
+		Long:  "It is used to delete cluster with the given name from user, or many clusters picked with --selector, --all or from the list",
 
 		Run: func(cmd *cobra.Command, args []string) {
 			clusters, err := k.fetchAllClusters()
@@ -52,45 +54,21 @@ ksctl delete --help
 				os.Exit(1)
 			}
 
-			selectDisplay := make(map[string]string, len(clusters))
-			valueMaping := make(map[string]controller.Metadata, len(clusters))
-
-			for idx, cluster := range clusters {
-				selectDisplay[makeHumanReadableList(cluster)] = strconv.Itoa(idx)
-				valueMaping[strconv.Itoa(idx)] = controller.Metadata{
-					ClusterName:   cluster.Name,
-					ClusterType:   cluster.ClusterType,
-					Provider:      cluster.CloudProvider,
-					Region:        cluster.Region,
-					StateLocation: k.KsctlConfig.PreferedStateStore,
-					K8sDistro:     cluster.K8sDistro,
-				}
-			}
-
-			selectedCluster, err := k.menuDriven.DropDown(
-				"Select the cluster to delete",
-				selectDisplay,
-			)
-			if err != nil {
-				k.l.Error("Failed to get userinput", "Reason", err)
+			targets, ok := k.targetClusters("Select the clusters to delete", clusters, bulk)
+			if !ok {
 				os.Exit(1)
 			}
 
-			m := valueMaping[selectedCluster]
-
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterDelete, telemetry.TelemetryMeta{
-				CloudProvider:     m.Provider,
-				StorageDriver:     m.StateLocation,
-				Region:            m.Region,
-				ClusterType:       m.ClusterType,
-				BootstrapProvider: m.K8sDistro,
-				K8sVersion:        m.K8sVersion,
-				Addons:            telemetry.TranslateMetadata(m.Addons),
-			}); err != nil {
-				k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
+			if len(targets) > 1 {
+				k.deleteClusters(targets, override, bulk.concurrency)
+				return
 			}
 
-			if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with the cluster deletion", cli.WithDefaultValue("no")); !ok {
+			m := k.clusterMetadata(targets[0])
+
+			k.guardProtected(m, "delete", override)
+
+			if !k.confirmClusterName(m.ClusterName, "deletion") {
 				os.Exit(1)
 			}
 
@@ -98,50 +76,97 @@ ksctl delete --help
 				os.Exit(1)
 			}
 
-			if m.ClusterType == consts.ClusterTypeMang {
-				c, err := managed.NewController(
-					k.Ctx,
-					k.l,
-					&controller.Client{
-						Metadata: m,
-					},
-				)
-				if err != nil {
-					k.l.Error("Failed to create the controller", "Reason", err)
-					os.Exit(1)
-				}
-
-				if err := c.Delete(); err != nil {
-					k.l.Error("Failed to delete your managed cluster", "Reason", err)
-					os.Exit(1)
-				}
-
-			} else {
-				c, err := selfmanaged.NewController(
-					k.Ctx,
-					k.l,
-					&controller.Client{
-						Metadata: m,
-					},
-				)
-				if err != nil {
-					k.l.Error("Failed to create the controller", "Reason", err)
-					os.Exit(1)
-				}
-
-				if err := c.Delete(); err != nil {
-					k.l.Error("Failed to delete your selfmanaged cluster", "Reason", err)
-					os.Exit(1)
-				}
+			if err := k.deleteCluster(m); err != nil {
+				k.l.Error("Failed to delete your cluster", "Reason", err)
+				os.Exit(1)
 			}
 
 			k.l.Success(k.Ctx, "Deleted your cluster", "Name", m.ClusterName)
 		},
 	}
 
+	cmd.Flags().BoolVar(&override, overrideProtectionFlag, false, "Delete the cluster even when it is protected")
+	addBulkFlags(cmd, &bulk)
+
 	return cmd
 }
 
+// deleteClusters deletes the clusters in parallel, the protected ones are skipped unless overridden
+func (k *KsctlCommand) deleteClusters(clusters []provider.ClusterData, override bool, concurrency int) {
+	k.showTargetClusters(clusters)
+
+	if !k.confirmClusterNames(clusters, "deletion") {
+		os.Exit(1)
+	}
+
+	if !k.loadBulkCreds(clusters) {
+		os.Exit(1)
+	}
+
+	results := k.runBulk("deletion", clusters, concurrency, func(c provider.ClusterData) (string, error) {
+		m := k.clusterMetadata(c)
+		if err := k.protectionError(m, "delete", override); err != nil {
+			return "", skipped(err.Error())
+		}
+		if err := k.deleteCluster(m); err != nil {
+			return "", err
+		}
+		return "deleted", nil
+	})
+
+	k.reportBulk("deletion", results)
+}
+
+// deleteCluster deletes the cluster and forgets its records, the credentials of its cloud must be loaded
+func (k *KsctlCommand) deleteCluster(m controller.Metadata) error {
+	if err := k.sendClusterTelemetry(m, telemetry.EventClusterDelete, telemetry.TelemetryMeta{
+		CloudProvider:     m.Provider,
+		StorageDriver:     m.StateLocation,
+		Region:            m.Region,
+		ClusterType:       m.ClusterType,
+		BootstrapProvider: m.K8sDistro,
+		K8sVersion:        m.K8sVersion,
+		Addons:            telemetry.TranslateMetadata(m.Addons),
+	}); err != nil {
+		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
+	}
+
+	if m.ClusterType == consts.ClusterTypeMang {
+		c, err := managed.NewController(
+			k.Ctx,
+			k.l,
+			&controller.Client{
+				Metadata: m,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create the controller: %w", err)
+		}
+
+		if err := c.Delete(); err != nil {
+			return fmt.Errorf("failed to delete the managed cluster: %w", err)
+		}
+	} else {
+		c, err := selfmanaged.NewController(
+			k.Ctx,
+			k.l,
+			&controller.Client{
+				Metadata: m,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create the controller: %w", err)
+		}
+
+		if err := c.Delete(); err != nil {
+			return fmt.Errorf("failed to delete the selfmanaged cluster: %w", err)
+		}
+	}
+
+	k.forgetCluster(m)
+	return nil
+}
+
 func makeHumanReadableList(m provider.ClusterData) string {
 	fields := []string{"%s", "[%s]", "=>"}
 	args := []any{m.Name, m.ClusterType}
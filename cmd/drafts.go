@@ -0,0 +1,416 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+func (w *createWizard) spec() config.ClusterSpec {
+	s := config.ClusterSpec{
+		Metadata:          *w.meta,
+		Archs:             w.archs,
+		Instances:         map[string]provider.InstanceRegionOutput{},
+		RecommendedCounts: map[string]int{},
+	}
+
+	for role, vm := range map[string]provider.InstanceRegionOutput{
+		roleControlPlane: w.cp,
+		roleDataStore:    w.etcd,
+		roleLoadBalancer: w.lb,
+		roleWorkerPlane:  w.wp,
+		roleManagedNodes: w.vm,
+	} {
+		if len(vm.Sku) != 0 {
+			s.Instances[role] = vm
+		}
+	}
+	if len(w.offering.Sku) != 0 {
+		s.ManagedOffering = &w.offering
+	}
+	s.RecommendedCounts[roleWorkerPlane] = w.defaultWP
+	s.RecommendedCounts[roleManagedNodes] = w.defaultMP
+
+	s.WorkerPools = w.workerPools()
+	for name, vm := range w.poolVMs {
+		s.Instances[poolInstanceKey(name)] = vm
+	}
+
+	return s
+}
+
+func (w *createWizard) applySpec(s config.ClusterSpec) {
+	*w.meta = s.Metadata
+	w.archs = s.Archs
+
+	w.cp = s.Instances[roleControlPlane]
+	w.etcd = s.Instances[roleDataStore]
+	w.lb = s.Instances[roleLoadBalancer]
+	w.wp = s.Instances[roleWorkerPlane]
+	w.vm = s.Instances[roleManagedNodes]
+	if s.ManagedOffering != nil {
+		w.offering = *s.ManagedOffering
+	}
+
+	if v, ok := s.RecommendedCounts[roleWorkerPlane]; ok {
+		w.defaultWP = v
+	}
+	if v, ok := s.RecommendedCounts[roleManagedNodes]; ok {
+		w.defaultMP = v
+	}
+
+	w.pools = s.WorkerPools
+	w.poolVMs = map[string]provider.InstanceRegionOutput{}
+	for _, p := range s.WorkerPools {
+		if vm, ok := s.Instances[poolInstanceKey(p.Name)]; ok {
+			w.poolVMs[p.Name] = vm
+		}
+	}
+}
+
+// saveDraft persists the answers once the cluster is named, the failures only warn as
+// the draft is a convenience
+func (w *createWizard) saveDraft() {
+	if w.draft == nil {
+		if len(w.meta.ClusterName) == 0 {
+			return
+		}
+		if !config.IsValidDraftName(w.meta.ClusterName) {
+			w.k.l.Debug(w.k.Ctx, "Cluster name can't be used as the draft name", "name", w.meta.ClusterName)
+			return
+		}
+		w.draft = &config.Draft{Name: w.meta.ClusterName}
+	}
+
+	w.draft.Answered = w.draft.Answered[:0]
+	for _, s := range w.steps {
+		if w.answered[s.name] {
+			w.draft.Answered = append(w.draft.Answered, s.name)
+		}
+	}
+	w.draft.Spec = w.spec()
+	if w.preset != nil {
+		w.draft.Preset = w.preset.Name
+	}
+
+	if err := config.SaveDraft(w.draft); err != nil {
+		w.k.l.Warn(w.k.Ctx, "Failed to save the draft", "Reason", err)
+		return
+	}
+
+	if !w.draftAnnounced {
+		w.draftAnnounced = true
+		w.k.l.Note(w.k.Ctx, "Answers are saved as a draft", "draft", w.draft.Name, "resume", "ksctl cluster create --resume "+w.draft.Name)
+	}
+}
+
+func (w *createWizard) completeDraft() {
+	if w.draft == nil {
+		return
+	}
+	w.draft.Completed = true
+	w.saveDraft()
+}
+
+// useDraft names the draft of the wizard, an existing draft with the name is overwritten
+func (w *createWizard) useDraft(name string) bool {
+	if !config.IsValidDraftName(name) {
+		w.k.l.Error("Invalid draft name", "name", name, "allowed", "letters, digits, '.', '_' and '-'")
+		return false
+	}
+	w.draft = &config.Draft{Name: name}
+	return true
+}
+
+// revalidate asks again the resumed answers which are no longer accepted, the name may be taken
+// by a cluster created since and the spec file may be edited by hand. The price step is always
+// run again as the prices and the budget may have changed
+func (w *createWizard) revalidate() {
+	m := w.meta
+	checks := map[string]error{
+		stepClusterName: w.k.validateNewClusterName()(m.ClusterName),
+	}
+	if m.ClusterType == consts.ClusterTypeSelfMang {
+		checks[stepNoCP] = oddQuorum(3)(m.NoCP)
+		checks[stepNoWP] = inRange(1, maxNodesPerPool)(m.NoWP)
+		checks[stepNoDS] = oddQuorum(3)(m.NoDS)
+	} else {
+		checks[stepNoMP] = inRange(1, maxNodesPerPool)(m.NoMP)
+	}
+
+	for _, s := range w.steps {
+		if err := checks[s.name]; err != nil && w.answered[s.name] {
+			w.k.l.Warn(w.k.Ctx, "The resumed answer is not valid, it is asked again", "step", s.name, "Reason", err)
+			delete(w.answered, s.name)
+		}
+	}
+	delete(w.answered, stepPrice)
+}
+
+// resume continues from a draft or from a cluster spec file, the spec is considered
+// answered completely so the wizard goes straight to the review once the answers are validated
+func (w *createWizard) resume(from string) bool {
+	if st, err := os.Stat(from); err == nil && !st.IsDir() {
+		s := config.ClusterSpec{}
+		if err := config.LoadClusterSpec(from, &s); err != nil {
+			w.k.l.Error("Failed to load the cluster spec", "Reason", err)
+			return false
+		}
+		w.applySpec(s)
+		for _, step := range w.steps {
+			w.answered[step.name] = true
+		}
+		w.k.l.Print(w.k.Ctx, "Using the cluster spec", "file", from)
+		w.revalidate()
+		return true
+	}
+
+	d := new(config.Draft)
+	if err := config.LoadDraft(from, d); err != nil {
+		w.k.l.Error("Failed to load the draft", "Reason", err)
+		return false
+	}
+	w.draft = d
+	w.applySpec(d.Spec)
+	for _, step := range d.Answered {
+		w.answered[step] = true
+	}
+	if len(d.Preset) != 0 && w.preset == nil {
+		p := new(config.Preset)
+		if err := config.LoadPreset(d.Preset, p); err != nil {
+			w.k.l.Warn(w.k.Ctx, "Failed to load the preset of the draft, the rest is asked", "preset", d.Preset, "Reason", err)
+		} else {
+			w.applyPreset(p)
+		}
+	}
+
+	w.k.l.Print(w.k.Ctx, "Resuming the draft", "draft", d.Name, "answered", len(d.Answered), "updated", d.UpdatedAt.Local().Format(time.DateTime))
+	w.revalidate()
+	return true
+}
+
+func (k *KsctlCommand) Drafts() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "drafts",
+		Example: `
+ksctl drafts --help
+`,
+		Short: "Use to manage the saved cluster create drafts",
+		Long:  "It is used to list, show, delete and export the answers saved by the cluster create wizard",
+	}
+
+	return cmd
+}
+
+// selectDraft returns the draft named in the args otherwise asks the user to pick one
+func (k *KsctlCommand) selectDraft(args []string) (*config.Draft, bool) {
+	name := ""
+	if len(args) != 0 {
+		name = args[0]
+	} else {
+		drafts, err := config.ListDrafts()
+		if err != nil {
+			k.l.Error("Failed to list the drafts", "Reason", err)
+			return nil, false
+		}
+		if len(drafts) == 0 {
+			k.l.Print(k.Ctx, "No drafts found")
+			return nil, false
+		}
+
+		options := make(map[string]string, len(drafts))
+		for _, d := range drafts {
+			options[fmt.Sprintf("%s (%s)", d.Name, draftStatus(d))] = d.Name
+		}
+		v, err := k.menuDriven.DropDown("Select the draft", options)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return nil, false
+		}
+		name = v
+	}
+
+	d := new(config.Draft)
+	if err := config.LoadDraft(name, d); err != nil {
+		k.l.Error("Failed to load the draft", "Reason", err)
+		return nil, false
+	}
+	return d, true
+}
+
+func draftStatus(d config.Draft) string {
+	if d.Completed {
+		return "completed"
+	}
+	return fmt.Sprintf("%d answered", len(d.Answered))
+}
+
+func (k *KsctlCommand) DraftsList() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "list",
+		Example: `
+ksctl drafts list
+`,
+		Short: "Use to list the drafts",
+		Long:  "It is used to list the saved cluster create drafts, the most recent first",
+		Run: func(cmd *cobra.Command, args []string) {
+			drafts, err := config.ListDrafts()
+			if err != nil {
+				k.l.Error("Failed to list the drafts", "Reason", err)
+				os.Exit(1)
+			}
+			if len(drafts) == 0 {
+				k.l.Print(k.Ctx, "No drafts found")
+				return
+			}
+
+			headers := []string{"Name", "Cluster", "Type", "Cloud", "Region", "Status", "Updated"}
+			rows := make([][]string, 0, len(drafts))
+			for _, d := range drafts {
+				m := d.Spec.Metadata
+				rows = append(rows, []string{
+					d.Name,
+					m.ClusterName,
+					string(m.ClusterType),
+					string(m.Provider),
+					m.Region,
+					draftStatus(d),
+					d.UpdatedAt.Local().Format(time.DateTime),
+				})
+			}
+			k.l.Table(k.Ctx, headers, rows)
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) DraftsShow() *cobra.Command {
+	output := ""
+
+	cmd := &cobra.Command{
+		Use: "show [draft]",
+		Example: `
+ksctl drafts show demo
+ksctl drafts show demo --output json
+`,
+		Short: "Use to show a draft",
+		Long:  "It is used to show the blueprint and the answered steps of a draft",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			d, ok := k.selectDraft(args)
+			if !ok {
+				os.Exit(1)
+			}
+
+			if output == cli.OutputJson {
+				if err := printJson(d); err != nil {
+					k.l.Error("Failed to print the draft", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			k.metadataSummary(d.Spec.Metadata, d.Spec.WorkerPools, 0, "")
+			k.l.Box(k.Ctx, "Draft "+d.Name, fmt.Sprintf(
+				"Status: %s\nAnswered: %s\nUpdated: %s",
+				color.HiCyanString(draftStatus(*d)),
+				strings.Join(d.Answered, ", "),
+				d.UpdatedAt.Local().Format(time.DateTime),
+			))
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+
+	return cmd
+}
+
+func (k *KsctlCommand) DraftsDelete() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "delete [draft]",
+		Example: `
+ksctl drafts delete demo
+`,
+		Short: "Use to delete a draft",
+		Long:  "It is used to delete a saved cluster create draft",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			d, ok := k.selectDraft(args)
+			if !ok {
+				os.Exit(1)
+			}
+
+			if err := config.DeleteDraft(d.Name); err != nil {
+				k.l.Error("Failed to delete the draft", "Reason", err)
+				os.Exit(1)
+			}
+			k.l.Success(k.Ctx, "Deleted the draft", "draft", d.Name)
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) DraftsExport() *cobra.Command {
+	file := ""
+
+	cmd := &cobra.Command{
+		Use: "export [draft]",
+		Example: `
+ksctl drafts export demo --file demo.json
+ksctl cluster create --resume demo.json
+`,
+		Short: "Use to export a completed draft as a cluster spec",
+		Long:  "It is used to export a completed draft as a cluster spec file which can be reused with $ksctl cluster create --resume <file>",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			d, ok := k.selectDraft(args)
+			if !ok {
+				os.Exit(1)
+			}
+			if !d.Completed {
+				k.l.Error("Only completed drafts can be exported", "draft", d.Name, "hint", "ksctl cluster create --resume "+d.Name)
+				os.Exit(1)
+			}
+
+			if len(file) == 0 {
+				file = d.Name + ".json"
+			}
+			if err := config.ExportClusterSpec(file, d.Spec); err != nil {
+				k.l.Error("Failed to export the draft", "Reason", err)
+				os.Exit(1)
+			}
+			k.l.Success(k.Ctx, "Exported the cluster spec", "draft", d.Name, "file", file)
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "Path of the cluster spec file (default <draft>.json)")
+
+	return cmd
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	controllerCommon "github.com/ksctl/ksctl/v2/pkg/handler/cluster/common"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	nodesGVR = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	podsGVR  = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+)
+
+// evictionRetryInterval is the wait before retrying an eviction blocked by a PodDisruptionBudget
+const evictionRetryInterval = 5 * time.Second
+
+type evictedPod struct {
+	Node      string
+	Namespace string
+	Name      string
+	Owner     string
+	uid       types.UID
+}
+
+// nodeClient operates on the nodes through the api server of the cluster
+type nodeClient struct {
+	client dynamic.Interface
+}
+
+func newNodeClient(kubeconfig []byte) (*nodeClient, error) {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the kubeconfig: %w", err)
+	}
+	client, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &nodeClient{client: client}, nil
+}
+
+// nodeCreation returns the creation time of the nodes by name
+func (d *nodeClient) nodeCreation(ctx context.Context) (map[string]time.Time, error) {
+	nodes, err := d.client.Resource(nodesGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]time.Time, len(nodes.Items))
+	for _, n := range nodes.Items {
+		res[n.GetName()] = n.GetCreationTimestamp().Time
+	}
+	return res, nil
+}
+
+// podsToEvict returns the pods of the node that the drain evicts, the DaemonSet, mirror
+// and completed pods are left as they are recreated on the node or don't run anymore
+func (d *nodeClient) podsToEvict(ctx context.Context, node string) ([]evictedPod, error) {
+	pods, err := d.client.Resource(podsGVR).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + node,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := []evictedPod{}
+	for _, p := range pods.Items {
+		if _, ok := p.GetAnnotations()["kubernetes.io/config.mirror"]; ok {
+			continue
+		}
+		phase, _, _ := unstructured.NestedString(p.Object, "status", "phase")
+		if phase == "Succeeded" || phase == "Failed" {
+			continue
+		}
+
+		owner, daemon := "", false
+		for _, o := range p.GetOwnerReferences() {
+			if o.Kind == "DaemonSet" {
+				daemon = true
+			}
+			owner = o.Kind + "/" + o.Name
+		}
+		if daemon {
+			continue
+		}
+		res = append(res, evictedPod{Node: node, Namespace: p.GetNamespace(), Name: p.GetName(), Owner: owner, uid: p.GetUID()})
+	}
+	return res, nil
+}
+
+func (d *nodeClient) setUnschedulable(ctx context.Context, node string, v bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, v)
+	_, err := d.client.Resource(nodesGVR).Patch(ctx, node, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+func (d *nodeClient) cordon(ctx context.Context, node string) error {
+	return d.setUnschedulable(ctx, node, true)
+}
+
+func (d *nodeClient) uncordon(ctx context.Context, node string) error {
+	return d.setUnschedulable(ctx, node, false)
+}
+
+// evict asks the api server to evict the pod, it is retried while a PodDisruptionBudget blocks it
+func (d *nodeClient) evict(ctx context.Context, p evictedPod) error {
+	eviction := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "policy/v1",
+		"kind":       "Eviction",
+		"metadata": map[string]any{
+			"name":      p.Name,
+			"namespace": p.Namespace,
+		},
+	}}
+
+	for {
+		_, err := d.client.Resource(podsGVR).Namespace(p.Namespace).Create(ctx, eviction, metav1.CreateOptions{}, "eviction")
+		switch {
+		case err == nil, apierrors.IsNotFound(err):
+			return nil
+		case apierrors.IsTooManyRequests(err):
+			// the PodDisruptionBudget doesn't allow the disruption right now
+		default:
+			return fmt.Errorf("failed to evict %s/%s: %w", p.Namespace, p.Name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out evicting %s/%s, it is blocked by a PodDisruptionBudget", p.Namespace, p.Name)
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+}
+
+// waitDeleted waits for the evicted pod to be gone, a pod with the same name and another uid is a new one
+func (d *nodeClient) waitDeleted(ctx context.Context, p evictedPod) error {
+	for {
+		pod, err := d.client.Resource(podsGVR).Namespace(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && pod.GetUID() != p.uid) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s/%s to terminate", p.Namespace, p.Name)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// drain cordons the node and evicts its pods, the whole drain has to finish within the timeout
+func (d *nodeClient) drain(ctx context.Context, node string, pods []evictedPod, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := d.cordon(ctx, node); err != nil {
+		return fmt.Errorf("failed to cordon %s: %w", node, err)
+	}
+	for _, p := range pods {
+		if err := d.evict(ctx, p); err != nil {
+			return err
+		}
+	}
+	for _, p := range pods {
+		if err := d.waitDeleted(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drainAll drains the nodes one at a time, on a failure the drained nodes are uncordoned
+func (d *nodeClient) drainAll(ctx context.Context, nodes []string, evicted []evictedPod, timeout time.Duration, progress func(node string, pods int)) error {
+	drained := []string{}
+	for _, n := range nodes {
+		pods := []evictedPod{}
+		for _, p := range evicted {
+			if p.Node == n {
+				pods = append(pods, p)
+			}
+		}
+
+		progress(n, len(pods))
+		drained = append(drained, n)
+		if err := d.drain(ctx, n, pods, timeout); err != nil {
+			errs := []error{fmt.Errorf("node %s: %w", n, err)}
+			for _, u := range drained {
+				if err := d.uncordon(ctx, u); err != nil {
+					errs = append(errs, fmt.Errorf("failed to uncordon %s: %w", u, err))
+				}
+			}
+			return errors.Join(errs...)
+		}
+	}
+	return nil
+}
+
+// clusterNodeClient connects to the api server of the cluster with its kubeconfig, ctx carries the credentials
+func (k *KsctlCommand) clusterNodeClient(ctx context.Context, m controller.Metadata) (*nodeClient, error) {
+	c, err := controllerCommon.NewController(ctx, k.l, &controller.Client{Metadata: m})
+	if err != nil {
+		return nil, err
+	}
+	kubeconfig, err := c.Switch()
+	if err != nil {
+		return nil, err
+	}
+	return newNodeClient([]byte(*kubeconfig))
+}
+
+// previewEvictions shows the workloads the drain of the nodes evicts, the nodes which
+// are not part of the cluster are removed without the drain
+func (k *KsctlCommand) previewEvictions(d *nodeClient, nodes []string, created map[string]time.Time) []evictedPod {
+	evicted := []evictedPod{}
+	for _, n := range nodes {
+		if _, ok := created[n]; !ok {
+			k.l.Warn(k.Ctx, "Node is not part of the cluster, it is removed without the drain", "node", n)
+			continue
+		}
+		pods, err := d.podsToEvict(k.Ctx, n)
+		if err != nil {
+			k.l.Error("Failed to get the pods of the node", "node", n, "Reason", err)
+			os.Exit(1)
+		}
+		evicted = append(evicted, pods...)
+	}
+
+	if len(evicted) == 0 {
+		k.l.Note(k.Ctx, "No workloads are evicted from the selected nodes")
+		return evicted
+	}
+
+	rows := make([][]string, 0, len(evicted))
+	for _, p := range evicted {
+		rows = append(rows, []string{p.Node, p.Namespace, p.Name, p.Owner})
+	}
+	k.l.Print(k.Ctx, "Workloads which will be evicted")
+	k.l.Table(k.Ctx, []string{"Node", "Namespace", "Pod", "Owner"}, rows)
+	return evicted
+}
+
+// drainNodes drains the nodes one at a time, on a failure the drained nodes are uncordoned
+// so the cluster is left as it was
+func (k *KsctlCommand) drainNodes(d *nodeClient, nodes []string, created map[string]time.Time, evicted []evictedPod, timeout time.Duration) {
+	present := slices.DeleteFunc(slices.Clone(nodes), func(n string) bool {
+		_, ok := created[n]
+		return !ok
+	})
+
+	if err := d.drainAll(k.Ctx, present, evicted, timeout, func(n string, pods int) {
+		k.l.Print(k.Ctx, "Draining the node", "node", n, "pods", pods)
+	}); err != nil {
+		k.l.Error("Failed to drain the nodes, the nodes are not removed", "Reason", err)
+		os.Exit(1)
+	}
+}
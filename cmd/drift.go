@@ -0,0 +1,432 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	addonsHandler "github.com/ksctl/ksctl/v2/pkg/handler/addons"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
+	"github.com/ksctl/ksctl/v2/pkg/logger"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// supportedK8sMinors is the number of the newest kubernetes minor versions which get patches upstream
+const supportedK8sMinors = 3
+
+const (
+	driftCurrent     = "current"
+	driftPatch       = "patch behind"
+	driftMinor       = "minor behind"
+	driftMajor       = "major behind"
+	driftUnsupported = "unsupported"
+	driftEndOfLife   = "end-of-life"
+	// driftUnknown is when the available versions or the current one can't be read
+	driftUnknown = "unknown"
+	// driftUntracked is when there is no catalog of the versions of the component
+	driftUntracked = "untracked"
+)
+
+var driftSeverity = map[string]int{
+	driftEndOfLife:   4,
+	driftUnsupported: 3,
+	driftMajor:       3,
+	driftMinor:       2,
+	driftPatch:       1,
+}
+
+var driftPriorities = []string{"none", "low", "medium", "high", "critical"}
+
+type componentDrift struct {
+	Component string `json:"component"`
+	Current   string `json:"current"`
+	Latest    string `json:"latest,omitempty"`
+	Status    string `json:"status"`
+	Severity  int    `json:"severity"`
+	Error     string `json:"error,omitempty"`
+}
+
+type clusterDrift struct {
+	Name          string                  `json:"name"`
+	CloudProvider consts.KsctlCloud       `json:"cloudProvider"`
+	ClusterType   consts.KsctlClusterType `json:"clusterType"`
+	Region        string                  `json:"region"`
+	Priority      string                  `json:"priority"`
+	Severity      int                     `json:"severity"`
+	Components    []componentDrift        `json:"components"`
+}
+
+type fleetDriftReport struct {
+	GeneratedAt time.Time      `json:"generatedAt"`
+	Clusters    []clusterDrift `json:"clusters"`
+}
+
+type k8sVersion struct {
+	major, minor, patch int
+}
+
+// parseK8sVersion parses the versions like v1.31.2, 1.31 and v1.31.2+k3s1
+func parseK8sVersion(v string) (k8sVersion, bool) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return k8sVersion{}, false
+	}
+
+	nums := [3]int{}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return k8sVersion{}, false
+		}
+		nums[i] = n
+	}
+	return k8sVersion{nums[0], nums[1], nums[2]}, true
+}
+
+func (a k8sVersion) compare(b k8sVersion) int {
+	return cmp.Or(cmp.Compare(a.major, b.major), cmp.Compare(a.minor, b.minor), cmp.Compare(a.patch, b.patch))
+}
+
+// versionDrift compares the version with the newest available one. With supportedMinors set the
+// versions that many minors behind the newest are end-of-life, the older versions which are not available
+// anymore are unsupported. A version newer than the catalog is current as the catalog lags the releases
+func versionDrift(current string, available []string, supportedMinors int) (string, string) {
+	cur, ok := parseK8sVersion(current)
+	if !ok || len(available) == 0 {
+		return "", driftUnknown
+	}
+
+	latest, newest := "", k8sVersion{}
+	listed := false
+	for _, a := range available {
+		v, ok := parseK8sVersion(a)
+		if !ok {
+			continue
+		}
+		if v.compare(newest) > 0 {
+			latest, newest = a, v
+		}
+		if v.compare(cur) == 0 {
+			listed = true
+		}
+	}
+	if len(latest) == 0 {
+		return "", driftUnknown
+	}
+	if cur.compare(newest) > 0 {
+		return latest, driftCurrent
+	}
+
+	switch {
+	case supportedMinors > 0 && (newest.major > cur.major || newest.minor-cur.minor >= supportedMinors):
+		return latest, driftEndOfLife
+	case !listed:
+		return latest, driftUnsupported
+	case newest.major != cur.major:
+		return latest, driftMajor
+	case newest.minor > cur.minor:
+		return latest, driftMinor
+	case newest.patch > cur.patch:
+		return latest, driftPatch
+	}
+	return latest, driftCurrent
+}
+
+func newComponentDrift(component, current string, available []string, err error, supportedMinors int) componentDrift {
+	d := componentDrift{Component: component, Current: current}
+	if err != nil {
+		d.Status, d.Error = driftUnknown, err.Error()
+		return d
+	}
+	d.Latest, d.Status = versionDrift(current, available, supportedMinors)
+	d.Severity = driftSeverity[d.Status]
+	return d
+}
+
+// versionCatalog caches the available versions so that the fleet needs a single lookup per catalog
+type versionCatalog struct {
+	versions map[string][]string
+	errs     map[string]error
+}
+
+func (v *versionCatalog) get(key string, list func() ([]string, error)) ([]string, error) {
+	if err, ok := v.errs[key]; ok {
+		return nil, err
+	}
+	if res, ok := v.versions[key]; ok {
+		return res, nil
+	}
+	res, err := list()
+	if err != nil {
+		v.errs[key] = err
+		return nil, err
+	}
+	v.versions[key] = res
+	return res, nil
+}
+
+func (k *KsctlCommand) clusterDrift(catalog *versionCatalog, c provider.ClusterData) clusterDrift {
+	d := clusterDrift{
+		Name:          c.Name,
+		CloudProvider: c.CloudProvider,
+		ClusterType:   c.ClusterType,
+		Region:        c.Region,
+	}
+
+	m := k.clusterMetadata(c)
+	var metaClient *controllerMeta.Controller
+	meta := func() (*controllerMeta.Controller, error) {
+		if metaClient != nil {
+			return metaClient, nil
+		}
+		if err := k.loadCloudProviderCreds(c.CloudProvider); err != nil {
+			return nil, err
+		}
+		v, err := controllerMeta.NewController(k.Ctx, k.l, &controller.Client{Metadata: m})
+		if err != nil {
+			return nil, err
+		}
+		metaClient = v
+		return v, nil
+	}
+	listed := func(list func(*controllerMeta.Controller) ([]string, error)) func() ([]string, error) {
+		return func() ([]string, error) {
+			mc, err := meta()
+			if err != nil {
+				return nil, err
+			}
+			return list(mc)
+		}
+	}
+
+	{
+		key := fmt.Sprintf("k8s/%s/%s/%s/%s", c.CloudProvider, c.ClusterType, c.K8sDistro, c.Region)
+		vers, err := catalog.get(key, listed(func(mc *controllerMeta.Controller) ([]string, error) {
+			if c.ClusterType == consts.ClusterTypeMang {
+				return mc.ListAllManagedClusterK8sVersions(c.Region)
+			}
+			return mc.ListAllBootstrapVersions()
+		}))
+		d.Components = append(d.Components, newComponentDrift("kubernetes", c.K8sVersion, vers, err, supportedK8sMinors))
+	}
+
+	if c.ClusterType == consts.ClusterTypeSelfMang {
+		if len(c.EtcdVersion) != 0 {
+			vers, err := catalog.get("etcd", listed((*controllerMeta.Controller).ListAllEtcdVersions))
+			d.Components = append(d.Components, newComponentDrift("etcd", c.EtcdVersion, vers, err, 0))
+		}
+		if len(c.HAProxyVersion) != 0 {
+			d.Components = append(d.Components, componentDrift{Component: "haproxy", Current: c.HAProxyVersion, Status: driftUntracked})
+		}
+	}
+
+	if name, ver := splitAppVersion(c.Cni); len(name) != 0 {
+		cni := componentDrift{Component: "cni/" + name, Current: ver, Status: driftUntracked}
+		switch {
+		case len(ver) == 0:
+		case name == string(consts.CNIFlannel):
+			vers, err := catalog.get("flannel", listed((*controllerMeta.Controller).ListAllFlannelVersions))
+			cni = newComponentDrift(cni.Component, ver, vers, err, 0)
+		case name == string(consts.CNICilium):
+			vers, err := catalog.get("cilium", listed((*controllerMeta.Controller).ListAllCiliumVersions))
+			cni = newComponentDrift(cni.Component, ver, vers, err, 0)
+		}
+		d.Components = append(d.Components, cni)
+	}
+
+	var addonClient *addonsHandler.Controller
+	for _, app := range c.Apps {
+		name, ver := splitAppVersion(app)
+		if len(name) == 0 {
+			continue
+		}
+		vers, err := catalog.get("addon/"+name, func() ([]string, error) {
+			if addonClient == nil {
+				if err := k.loadCloudProviderCreds(c.CloudProvider); err != nil {
+					return nil, err
+				}
+				v, err := addonsHandler.NewController(k.Ctx, k.l, &controller.Client{Metadata: m})
+				if err != nil {
+					return nil, err
+				}
+				addonClient = v
+			}
+			return addonClient.ListAvailableVersions(name)
+		})
+		d.Components = append(d.Components, newComponentDrift("addon/"+name, ver, vers, err, 0))
+	}
+
+	for _, comp := range d.Components {
+		d.Severity = max(d.Severity, comp.Severity)
+	}
+	d.Priority = driftPriorities[d.Severity]
+	return d
+}
+
+func (k *KsctlCommand) buildFleetDriftReport(clusters []provider.ClusterData, now time.Time) fleetDriftReport {
+	catalog := &versionCatalog{versions: map[string][]string{}, errs: map[string]error{}}
+
+	report := fleetDriftReport{GeneratedAt: now}
+	for _, c := range clusters {
+		report.Clusters = append(report.Clusters, k.clusterDrift(catalog, c))
+	}
+
+	// the clusters to upgrade first come first
+	slices.SortStableFunc(report.Clusters, func(a, b clusterDrift) int {
+		return cmp.Or(cmp.Compare(b.Severity, a.Severity), cmp.Compare(driftFindings(b), driftFindings(a)), cmp.Compare(a.Name, b.Name))
+	})
+	return report
+}
+
+func driftFindings(d clusterDrift) int {
+	n := 0
+	for _, c := range d.Components {
+		if c.Severity > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func colorDriftStatus(status string) string {
+	switch driftSeverity[status] {
+	case 4, 3:
+		return color.HiRedString(status)
+	case 2, 1:
+		return color.HiYellowString(status)
+	}
+	if status == driftCurrent {
+		return color.HiGreenString(status)
+	}
+	return status
+}
+
+func handleTableOutputDrift(ctx context.Context, l logger.Logger, report fleetDriftReport) {
+	{
+		headers := []string{"Cluster", "Component", "Current", "Latest", "Status"}
+		rows := [][]string{}
+		for _, c := range report.Clusters {
+			for _, comp := range c.Components {
+				rows = append(rows, []string{c.Name, comp.Component, comp.Current, comp.Latest, colorDriftStatus(comp.Status)})
+			}
+		}
+		l.Table(ctx, headers, rows)
+		fmt.Println()
+	}
+
+	{
+		headers := []string{"Priority", "Cluster", "Cloud", "Region", "Findings", "Worst"}
+		rows := make([][]string, 0, len(report.Clusters))
+		for _, c := range report.Clusters {
+			worst := ""
+			for _, comp := range c.Components {
+				if comp.Severity == c.Severity && comp.Severity > 0 {
+					worst = fmt.Sprintf("%s %s is %s", comp.Component, comp.Current, comp.Status)
+					break
+				}
+			}
+			rows = append(rows, []string{c.Priority, c.Name, string(c.CloudProvider), c.Region, strconv.Itoa(driftFindings(c)), worst})
+		}
+		l.Table(ctx, headers, rows)
+	}
+
+	l.Note(ctx, fmt.Sprintf("Kubernetes versions %d minors behind the newest available are end-of-life", supportedK8sMinors))
+}
+
+func (k *KsctlCommand) Fleet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "fleet",
+		Example: `
+ksctl fleet --help
+`,
+		Short: "Use to get the reports across all the clusters",
+		Long:  "It is used to get the reports which compare all the clusters of the fleet",
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) FleetDrift() *cobra.Command {
+	output := ""
+	selector := ""
+
+	cmd := &cobra.Command{
+		Use: "drift",
+		Example: `
+ksctl fleet drift
+ksctl fleet drift --selector env=prod
+ksctl fleet drift --output json
+`,
+		Short: "Use to find the clusters running outdated versions",
+		Long: "It is used to compare the kubernetes, etcd, haproxy, cni and addon versions of every cluster with the newest ones " +
+			"available from the metadata and the addon catalogs, to flag the unsupported and end-of-life versions and to rank the clusters to upgrade first",
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			records, ok := k.selectorClusterRecords(selector)
+			if !ok {
+				os.Exit(1)
+			}
+			clusters, err = selectClusters(clusters, records, selector)
+			if err != nil {
+				k.l.Error("Failed to filter the clusters", "Reason", err)
+				os.Exit(1)
+			}
+
+			if len(clusters) == 0 {
+				k.l.Print(k.Ctx, "No clusters found")
+				return
+			}
+
+			report := k.buildFleetDriftReport(clusters, time.Now().UTC())
+
+			if output == cli.OutputJson {
+				if err := printJson(report); err != nil {
+					k.l.Error("Failed to print the drift report", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			handleTableOutputDrift(k.Ctx, k.l, report)
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector of the clusters to report, e.g. env=prod")
+
+	return cmd
+}
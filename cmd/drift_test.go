@@ -0,0 +1,79 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import "testing"
+
+func TestParseK8sVersion(t *testing.T) {
+	for _, tc := range []struct {
+		v    string
+		want k8sVersion
+		ok   bool
+	}{
+		{"v1.31.2", k8sVersion{1, 31, 2}, true},
+		{"1.31.2", k8sVersion{1, 31, 2}, true},
+		{"1.31", k8sVersion{1, 31, 0}, true},
+		{" v1.30.0 ", k8sVersion{1, 30, 0}, true},
+		{"v1.31.2+k3s1", k8sVersion{1, 31, 2}, true},
+		{"v1.32.0-rc.1", k8sVersion{1, 32, 0}, true},
+		{"", k8sVersion{}, false},
+		{"v1", k8sVersion{}, false},
+		{"v1.31.2.4", k8sVersion{}, false},
+		{"v1.x.2", k8sVersion{}, false},
+		{"latest", k8sVersion{}, false},
+	} {
+		got, ok := parseK8sVersion(tc.v)
+		if ok != tc.ok || got != tc.want {
+			t.Errorf("parseK8sVersion(%q) = %v, %v, want %v, %v", tc.v, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestVersionDrift(t *testing.T) {
+	available := []string{"v1.29.10", "v1.30.4", "v1.31.0", "v1.31.3", "v1.32.0", "v1.32.2", "invalid"}
+
+	for _, tc := range []struct {
+		name            string
+		current         string
+		available       []string
+		supportedMinors int
+		latest          string
+		status          string
+	}{
+		{"newest", "v1.32.2", available, 3, "v1.32.2", driftCurrent},
+		{"distro suffix is ignored", "v1.32.2+k3s1", available, 3, "v1.32.2", driftCurrent},
+		{"patch behind", "v1.32.0", available, 3, "v1.32.2", driftPatch},
+		{"minor behind", "v1.31.3", available, 3, "v1.32.2", driftMinor},
+		{"minor behind without the support window", "v1.29.10", available, 0, "v1.32.2", driftMinor},
+		{"end-of-life", "v1.29.10", available, 3, "v1.32.2", driftEndOfLife},
+		{"end-of-life wins over unsupported", "v1.28.0", available, 3, "v1.32.2", driftEndOfLife},
+		{"older and not available anymore", "v1.31.1", available, 3, "v1.32.2", driftUnsupported},
+		{"newer patch than the catalog", "v1.32.5", available, 3, "v1.32.2", driftCurrent},
+		{"newer minor than the catalog", "v1.33.0", available, 3, "v1.32.2", driftCurrent},
+		{"newer major than the catalog", "v2.0.0", available, 3, "v1.32.2", driftCurrent},
+		{"major behind", "v1.32.2", []string{"v1.32.2", "v2.0.0"}, 0, "v2.0.0", driftMajor},
+		{"major behind is end-of-life with the support window", "v1.32.2", []string{"v1.32.2", "v2.0.0"}, 3, "v2.0.0", driftEndOfLife},
+		{"unparsable current", "latest", available, 3, "", driftUnknown},
+		{"empty catalog", "v1.32.2", nil, 3, "", driftUnknown},
+		{"unparsable catalog", "v1.32.2", []string{"stable"}, 3, "", driftUnknown},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			latest, status := versionDrift(tc.current, tc.available, tc.supportedMinors)
+			if latest != tc.latest || status != tc.status {
+				t.Errorf("versionDrift(%q) = %q, %q, want %q, %q", tc.current, latest, status, tc.latest, tc.status)
+			}
+		})
+	}
+}
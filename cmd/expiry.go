@@ -0,0 +1,250 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// parseTTL parses the durations like 90m, 8h, 7d and 7d12h, the days come first
+func parseTTL(v string) (time.Duration, error) {
+	days, rest, ok := strings.Cut(v, "d")
+	if !ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ttl %q, use the units m, h or d", v)
+		}
+		return d, nil
+	}
+
+	n, err := strconv.Atoi(days)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl %q, the days must be a whole number before the other units", v)
+	}
+	d := time.Duration(n) * 24 * time.Hour
+	if len(rest) != 0 {
+		r, err := time.ParseDuration(rest)
+		if err != nil || strings.ContainsAny(rest[:1], "+-") {
+			return 0, fmt.Errorf("invalid ttl %q, use the units m, h or d", v)
+		}
+		d += r
+	}
+	return d, nil
+}
+
+// parseExpiry returns when the cluster expires from the ttl or the date, nil when neither is set.
+// The date without a time expires at the start of the day in the local time
+func parseExpiry(ttl, expires string, now time.Time) (*time.Time, error) {
+	switch {
+	case len(ttl) != 0 && len(expires) != 0:
+		return nil, fmt.Errorf("only one of --ttl and --expires can be set")
+	case len(ttl) != 0:
+		d, err := parseTTL(ttl)
+		if err != nil {
+			return nil, err
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("ttl must be positive")
+		}
+		at := now.Add(d).UTC()
+		return &at, nil
+	case len(expires) != 0:
+		var at time.Time
+		var err error
+		for _, layout := range []string{time.RFC3339, time.DateTime, time.DateOnly} {
+			if at, err = time.ParseInLocation(layout, expires, time.Local); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry %q, use a date like 2026-11-01 or 2026-11-01 18:00:00", expires)
+		}
+		if !at.After(now) {
+			return nil, fmt.Errorf("expiry %s is in the past", at.Format(time.DateTime))
+		}
+		at = at.UTC()
+		return &at, nil
+	}
+	return nil, nil
+}
+
+func formatDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	h, m := d/time.Hour, (d%time.Hour)/time.Minute
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, h)
+	case h > 0:
+		return fmt.Sprintf("%dh%dm", h, m)
+	default:
+		return fmt.Sprintf("%dm", m)
+	}
+}
+
+// remainingTime is the time left before the cluster expires, empty when it doesn't
+func remainingTime(rec *config.ClusterRecord, now time.Time) string {
+	if rec == nil || rec.ExpiresAt == nil {
+		return ""
+	}
+	if left := rec.ExpiresAt.Sub(now); left > 0 {
+		return formatDuration(left)
+	}
+	return "expired " + formatDuration(now.Sub(*rec.ExpiresAt)) + " ago"
+}
+
+func isExpired(rec *config.ClusterRecord, now time.Time) bool {
+	return rec != nil && rec.ExpiresAt != nil && !rec.ExpiresAt.After(now)
+}
+
+func (k *KsctlCommand) recordClusterExpiry(m controller.Metadata, at *time.Time) {
+	if at == nil {
+		return
+	}
+	if err := k.updateClusterRecord(m, func(r *config.ClusterRecord) {
+		r.ExpiresAt = at
+	}); err != nil {
+		k.l.Warn(k.Ctx, "Failed to record the cluster expiry", "Reason", err)
+		return
+	}
+	k.l.Note(k.Ctx, "Cluster expires", "at", at.Local().Format(time.DateTime), "hint", "ksctl cluster reap")
+}
+
+// warnExpiredClusters reminds about the expired clusters from the records of the state store, the
+// clusters are not listed so that every command stays fast
+func (k *KsctlCommand) warnExpiredClusters() {
+	r, err := k.readClusterRecords()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	expired := []string{}
+	for _, rec := range r.Clusters {
+		if isExpired(rec, now) && !rec.Protected {
+			expired = append(expired, rec.Name)
+		}
+	}
+	if len(expired) == 0 {
+		return
+	}
+	slices.Sort(expired)
+	k.l.Warn(k.Ctx, "Clusters have expired and are still running", "clusters", strings.Join(expired, ", "), "hint", "ksctl cluster reap")
+}
+
+func (k *KsctlCommand) Reap() *cobra.Command {
+	yes := false
+
+	cmd := &cobra.Command{
+		Use: "reap",
+		Example: `
+ksctl cluster reap
+ksctl cluster reap --yes
+`,
+		Short: "Use to delete the expired clusters",
+		Long: "It is used to list the clusters past their expiry set with --ttl or --expires at creation, and to delete them after the confirmation. " +
+			"The expiry is kept with the cluster records in the configured state store, so only the clusters of that store are reaped",
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+			records, err := k.readClusterRecords()
+			if err != nil {
+				k.l.Error("Failed to load the cluster records", "Reason", err)
+				os.Exit(1)
+			}
+			now := time.Now()
+
+			expired := []provider.ClusterData{}
+			existing := map[string]bool{}
+			for _, c := range clusters {
+				existing[clusterRecordKey(c)] = true
+				rec, ok := records.Get(clusterRecordKey(c))
+				if !ok || !isExpired(rec, now) {
+					continue
+				}
+				if rec.Protected {
+					k.l.Note(k.Ctx, "Skipping the expired cluster as it is protected", "cluster", c.Name)
+					continue
+				}
+				expired = append(expired, c)
+			}
+
+			// the clusters deleted outside of the cli would be warned about forever, the records
+			// are of the same state store as the clusters
+			for key, rec := range records.Clusters {
+				if !existing[key] && isExpired(rec, now) {
+					k.l.Debug(k.Ctx, "Forgetting the expired cluster which no longer exists", "cluster", rec.Name)
+					k.forgetClusterRecord(key)
+				}
+			}
+
+			if len(expired) == 0 {
+				k.l.Success(k.Ctx, "No expired clusters")
+				return
+			}
+
+			rows := make([][]string, 0, len(expired))
+			for _, c := range expired {
+				rec, _ := records.Get(clusterRecordKey(c))
+				rows = append(rows, []string{c.Name, string(c.ClusterType), string(c.CloudProvider), c.Region, rec.ExpiresAt.Local().Format(time.DateTime), remainingTime(rec, now)})
+			}
+			k.l.Table(k.Ctx, []string{"Name", "Type", "Cloud", "Region", "ExpiresAt", "Status"}, rows)
+
+			if !yes {
+				if ok, _ := k.menuDriven.Confirmation(fmt.Sprintf("Do you want to delete the %d expired clusters", len(expired)), cli.WithDefaultValue("no")); !ok {
+					os.Exit(1)
+				}
+			}
+
+			if !k.loadBulkCreds(expired) {
+				os.Exit(1)
+			}
+
+			failed := 0
+			for _, c := range expired {
+				if err := k.deleteCluster(k.clusterMetadata(c)); err != nil {
+					k.l.Warn(k.Ctx, "Failed to delete the expired cluster", "cluster", c.Name, "Reason", err)
+					failed++
+					continue
+				}
+				k.l.Success(k.Ctx, "Deleted the expired cluster", "cluster", c.Name)
+			}
+
+			if failed != 0 {
+				k.l.Error("Failed to delete some of the expired clusters", "failed", failed, "total", len(expired))
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Delete without the confirmation")
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTTL(t *testing.T) {
+	for _, tc := range []struct {
+		v    string
+		want time.Duration
+		ok   bool
+	}{
+		{"90m", 90 * time.Minute, true},
+		{"8h", 8 * time.Hour, true},
+		{"7d", 7 * 24 * time.Hour, true},
+		{"7d12h", 7*24*time.Hour + 12*time.Hour, true},
+		{"1d30m", 24*time.Hour + 30*time.Minute, true},
+		{"0d", 0, true},
+		{"-1d", -24 * time.Hour, true},
+		{"12h7d", 0, false},
+		{"1.5d", 0, false},
+		{"1d-2h", 0, false},
+		{"d", 0, false},
+		{"7days", 0, false},
+		{"", 0, false},
+	} {
+		got, err := parseTTL(tc.v)
+		if (err == nil) != tc.ok || got != tc.want {
+			t.Errorf("parseTTL(%q) = %v, %v, want %v, ok %v", tc.v, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("IST", 5*60*60+30*60)
+	t.Cleanup(func() { time.Local = local })
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name    string
+		ttl     string
+		expires string
+		want    time.Time
+		ok      bool
+	}{
+		{name: "neither is set", ok: true},
+		{name: "ttl in days", ttl: "7d", want: now.Add(7 * 24 * time.Hour), ok: true},
+		{name: "ttl in mixed units", ttl: "1d12h", want: now.Add(36 * time.Hour), ok: true},
+		{name: "zero ttl", ttl: "0h"},
+		{name: "negative ttl", ttl: "-2h"},
+		{name: "invalid ttl", ttl: "soon"},
+		{name: "date only is the start of the local day", expires: "2026-11-01", want: time.Date(2026, 10, 31, 18, 30, 0, 0, time.UTC), ok: true},
+		{name: "date and time are local", expires: "2026-10-20 09:00:00", want: time.Date(2026, 10, 20, 3, 30, 0, 0, time.UTC), ok: true},
+		{name: "rfc3339 keeps the offset", expires: "2026-10-20T09:00:00Z", want: time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC), ok: true},
+		{name: "date in the past", expires: "2026-10-01"},
+		{name: "today has already started", expires: "2026-10-19"},
+		{name: "invalid date", expires: "next week"},
+		{name: "both flags are set", ttl: "8h", expires: "2026-11-01"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseExpiry(tc.ttl, tc.expires, now)
+			if (err == nil) != tc.ok {
+				t.Fatalf("err = %v, want ok %v", err, tc.ok)
+			}
+			switch {
+			case got == nil && !tc.want.IsZero():
+				t.Errorf("got nil, want %v", tc.want)
+			case got != nil && (!got.Equal(tc.want) || got.Location() != time.UTC):
+				t.Errorf("got %v, want %v in UTC", got, tc.want)
+			}
+		})
+	}
+}
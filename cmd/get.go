@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ksctl/cli/v2/pkg/config"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/logger"
@@ -67,7 +68,7 @@ ksctl get --help
 
 			cluster := valueMaping[selectedCluster]
 
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterGet, telemetry.TelemetryMeta{
+			if err := k.sendClusterTelemetry(k.clusterMetadata(cluster), telemetry.EventClusterGet, telemetry.TelemetryMeta{
 				CloudProvider:     cluster.CloudProvider,
 				StorageDriver:     k.KsctlConfig.PreferedStateStore,
 				Region:            cluster.Region,
@@ -78,7 +79,13 @@ ksctl get --help
 				k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
 			}
 
-			handleTableOutputGet(k.Ctx, k.l, cluster)
+			rec, _ := k.loadClusterRecords().Get(clusterRecordKey(cluster))
+			var pools []config.WorkerPool
+			if cluster.ClusterType == consts.ClusterTypeSelfMang {
+				pools = workerPoolsOf(cluster, rec)
+			}
+
+			handleTableOutputGet(k.Ctx, k.l, cluster, pools, rec)
 
 		},
 	}
@@ -86,7 +93,7 @@ ksctl get --help
 	return cmd
 }
 
-func handleTableOutputGet(ctx context.Context, l logger.Logger, data provider.ClusterData) {
+func handleTableOutputGet(ctx context.Context, l logger.Logger, data provider.ClusterData, pools []config.WorkerPool, rec *config.ClusterRecord) {
 
 	headers := []string{"Attributes", "Values"}
 	dataToPrint := [][]string{
@@ -115,6 +122,13 @@ func handleTableOutputGet(ctx context.Context, l logger.Logger, data provider.Cl
 			[]string{"BootstrapKubernetesVersion", data.K8sVersion},
 			[]string{"ControlPlaneNodes", nodes(data.CP)},
 			[]string{"WorkerPlaneNodes", nodes(data.WP)},
+			[]string{"WorkerPools", func() string {
+				res := make([]string, 0, len(pools))
+				for _, p := range pools {
+					res = append(res, formatWorkerPool(p))
+				}
+				return strings.Join(res, "; ")
+			}()},
 			[]string{"EtcdNodes", nodes(data.DS)},
 			[]string{"LoadBalancer", data.LB.VMSize},
 			[]string{"EtcdVersion", data.EtcdVersion},
@@ -133,5 +147,13 @@ func handleTableOutputGet(ctx context.Context, l logger.Logger, data provider.Cl
 		[]string{"CNI", data.Cni},
 	)
 
+	if rec != nil {
+		dataToPrint = append(dataToPrint,
+			[]string{"Owner", rec.Owner},
+			[]string{"Labels", formatLabels(rec.Labels)},
+			[]string{"Notes", rec.Notes},
+		)
+	}
+
 	l.Table(ctx, headers, dataToPrint)
 }
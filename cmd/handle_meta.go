@@ -21,6 +21,7 @@ import (
 	"os/exec"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
 	"github.com/ksctl/ksctl/v2/pkg/addons"
 	"github.com/ksctl/ksctl/v2/pkg/bootstrap/handler/cni"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
@@ -32,106 +33,128 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-func (k *KsctlCommand) baseMetadataFields(m *controller.Metadata) {
-	if v, ok := k.getClusterName(); !ok {
-		os.Exit(1)
-	} else {
-		m.ClusterName = v
-	}
+func (k *KsctlCommand) fetchRegions(meta *controllerMeta.Controller) (provider.RegionsOutput, error) {
+	ss := k.menuDriven.GetProgressAnimation()
+	ss.Start("Fetching the region list")
 
-	if v, ok := k.getSelectedClusterType(); !ok {
-		os.Exit(1)
-	} else {
-		m.ClusterType = v
+	listOfRegions, err := meta.ListAllRegions()
+	ss.Stop()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync the metadata: %w", err)
 	}
+	return listOfRegions, nil
+}
 
-	if v, ok := k.getSelectedCloudProvider(m.ClusterType); !ok {
-		os.Exit(1)
-	} else {
-		m.Provider = v
+func (k *KsctlCommand) handleRegionSelection(meta *controllerMeta.Controller, m *controller.Metadata) ([]provider.RegionOutput, error) {
+	listOfRegions, err := k.fetchRegions(meta)
+	if err != nil {
+		return nil, err
 	}
 
-	if v, ok := k.getSelectedStorageDriver(); !ok {
-		os.Exit(1)
-	} else {
-		m.StateLocation = consts.KsctlStore(v)
-	}
+	k.l.Note(k.Ctx, "Carbon emission data shown represents monthly averages calculated over a one-year period")
+	k.l.Note(k.Ctx, "Select the region for the cluster")
 
-	if m.ClusterType == consts.ClusterTypeSelfMang {
-		if v, ok := k.getBootstrap(); ok {
-			m.K8sDistro = v
-		} else {
-			os.Exit(1)
-		}
+	v, err := k.menuDriven.CardSelection(
+		cli.ConverterForRegionOutputForCards(listOfRegions),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the region: %w", err)
+	}
+	if v == "" {
+		return nil, fmt.Errorf("region not selected")
 	}
+	k.l.Debug(k.Ctx, "Selected region", "Region", v)
+	m.Region = v
+
+	return listOfRegions, nil
 }
 
-func (k *KsctlCommand) handleRegionSelection(meta *controllerMeta.Controller, m *controller.Metadata) []provider.RegionOutput {
-	ss := k.menuDriven.GetProgressAnimation()
-	ss.Start("Fetching the region list")
+func (k *KsctlCommand) handleInstanceCategorySelection() (provider.MachineCategory, error) {
+	v := provider.GetAvailableMachineCategories()
 
-	listOfRegions, err := meta.ListAllRegions()
+	_v, err := k.getSelectedInstanceCategory(v)
 	if err != nil {
+		return "", fmt.Errorf("failed to get the instance category: %w", err)
+	}
+	return _v, nil
+}
+
+// instanceTypesInRegion fetches the instance types of the selected region once per run
+func (k *KsctlCommand) instanceTypesInRegion(meta *controllerMeta.Controller, m *controller.Metadata) (provider.InstancesRegionOutput, error) {
+	if len(k.inMemInstanceTypesInReg) == 0 {
+		ss := k.menuDriven.GetProgressAnimation()
+		ss.Start("Fetching the instance type list")
+
+		listOfVMs, err := meta.ListAllInstances(m.Region)
 		ss.Stop()
-		k.l.Error("Failed to sync the metadata", "Reason", err)
-		os.Exit(1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sync the metadata: %w", err)
+		}
+		k.inMemInstanceTypesInReg = listOfVMs
 	}
-	ss.Stop()
+	return k.inMemInstanceTypesInReg, nil
+}
 
-	k.l.Note(k.Ctx, "Carbon emission data shown represents monthly averages calculated over a one-year period")
-	k.l.Note(k.Ctx, "Select the region for the cluster")
+// handleArchSelection returns the cpu architecture for every role where an empty one means any architecture
+func (k *KsctlCommand) handleArchSelection(m *controller.Metadata, roles ...string) (map[string]provider.MachineArch, error) {
+	res := make(map[string]provider.MachineArch, len(roles))
 
-	if v, err := k.menuDriven.CardSelection(
-		cli.ConverterForRegionOutputForCards(listOfRegions),
-	); err != nil {
-		k.l.Error("Failed to get the region", "Reason", err)
-		os.Exit(1)
-	} else {
-		if v == "" {
-			k.l.Error("Region not selected")
-			os.Exit(1)
+	toArch := func(v string) provider.MachineArch {
+		if v == archAny {
+			return ""
 		}
-		k.l.Debug(k.Ctx, "Selected region", "Region", v)
-		m.Region = v
+		return provider.MachineArch(v)
 	}
 
-	return listOfRegions
-}
+	if m.Provider == consts.CloudLocal {
+		for _, r := range roles {
+			res[r] = provider.ArchAmd64
+		}
+		return res, nil
+	}
 
-func (k *KsctlCommand) handleInstanceCategorySelection() provider.MachineCategory {
-	v := provider.GetAvailableMachineCategories()
+	const perRole = "per-role"
+	extra := map[string]string{}
+	if len(roles) > 1 {
+		extra["Choose for every role"] = perRole
+	}
 
-	_v, ok := k.getSelectedInstanceCategory(v)
-	if !ok {
-		k.l.Error("Failed to get the instance category")
-		os.Exit(1)
+	v, err := k.getSelectedInstanceArch("Select the cpu architecture of the nodes", extra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the cpu architecture: %w", err)
+	}
+
+	for _, r := range roles {
+		if v != perRole {
+			res[r] = toArch(v)
+			continue
+		}
+
+		_v, err := k.getSelectedInstanceArch(fmt.Sprintf("Select the cpu architecture for %s", r), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the cpu architecture of %s: %w", r, err)
+		}
+		res[r] = toArch(_v)
 	}
-	return _v
+
+	return res, nil
 }
 
 func (k *KsctlCommand) handleInstanceTypeSelection(
 	meta *controllerMeta.Controller,
 	m *controller.Metadata,
 	category provider.MachineCategory,
+	arch provider.MachineArch,
 	prompt string,
-) provider.InstanceRegionOutput {
+) (provider.InstanceRegionOutput, error) {
 
 	if len(k.inMemInstanceTypesInReg) == 0 {
 		if len(category) == 0 {
-			k.l.Error("Machine category is not provided")
-			os.Exit(1)
-		}
-		ss := k.menuDriven.GetProgressAnimation()
-		ss.Start("Fetching the instance type list")
-
-		listOfVMs, err := meta.ListAllInstances(m.Region)
-		if err != nil {
-			ss.Stop()
-			k.l.Error("Failed to sync the metadata", "Reason", err)
-			os.Exit(1)
+			return provider.InstanceRegionOutput{}, fmt.Errorf("machine category is not provided")
 		}
-		ss.Stop()
-		k.inMemInstanceTypesInReg = listOfVMs
+	}
+	if _, err := k.instanceTypesInRegion(meta, m); err != nil {
+		return provider.InstanceRegionOutput{}, err
 	}
 
 	availableOptions := make(provider.InstancesRegionOutput, 0, len(k.inMemInstanceTypesInReg))
@@ -139,7 +162,7 @@ func (k *KsctlCommand) handleInstanceTypeSelection(
 	k.l.Note(k.Ctx, prompt)
 
 	for _, v := range k.inMemInstanceTypesInReg {
-		if v.Category == category && v.CpuArch == provider.ArchAmd64 {
+		if v.Category == category && (len(arch) == 0 || v.CpuArch == arch) {
 			availableOptions = append(availableOptions, v)
 		}
 	}
@@ -148,21 +171,35 @@ func (k *KsctlCommand) handleInstanceTypeSelection(
 		cli.ConverterForInstanceTypesForCards(availableOptions),
 	)
 	if err != nil {
-		k.l.Error("Failed to get the instance type from user", "Reason", err)
-		os.Exit(1)
+		return provider.InstanceRegionOutput{}, fmt.Errorf("failed to get the instance type from user: %w", err)
 	}
 	if v == "" {
-		k.l.Error("Instance type not selected")
-		os.Exit(1)
+		return provider.InstanceRegionOutput{}, fmt.Errorf("instance type not selected")
 	}
 
 	_v, ok := availableOptions.Get(v)
 	if !ok {
-		k.l.Error("Failed to get the instance type")
-		os.Exit(1)
+		return provider.InstanceRegionOutput{}, fmt.Errorf("failed to get the instance type %s", v)
 	}
 
-	return *_v
+	return *_v, nil
+}
+
+// askWorkerInstanceType asks the cpu architecture, the category and the instance type of new worker nodes
+func (k *KsctlCommand) askWorkerInstanceType(meta *controllerMeta.Controller, m *controller.Metadata, prompt string) (provider.InstanceRegionOutput, error) {
+	archs, err := k.handleArchSelection(m, roleWorkerPlane)
+	if err != nil {
+		return provider.InstanceRegionOutput{}, err
+	}
+
+	category := provider.Unknown
+	if m.Provider != consts.CloudLocal {
+		if category, err = k.handleInstanceCategorySelection(); err != nil {
+			return provider.InstanceRegionOutput{}, err
+		}
+	}
+
+	return k.handleInstanceTypeSelection(meta, m, category, archs[roleWorkerPlane], prompt)
 }
 
 func (k *KsctlCommand) getSpecificInstanceForScaledown(
@@ -193,29 +230,31 @@ func (k *KsctlCommand) getSpecificInstanceForScaledown(
 	return *v
 }
 
-func (k *KsctlCommand) handleManagedK8sVersion(meta *controllerMeta.Controller, m *controller.Metadata) {
+func (k *KsctlCommand) handleManagedK8sVersion(meta *controllerMeta.Controller, m *controller.Metadata) error {
 	ss := k.menuDriven.GetProgressAnimation()
 	ss.Start("Fetching the managed cluster k8s versions")
 
 	listOfK8sVersions, err := meta.ListAllManagedClusterK8sVersions(m.Region)
+	ss.Stop()
 	if err != nil {
-		ss.Stop()
-		k.l.Error("Failed to sync the metadata", "Reason", err)
-		os.Exit(1)
+		return fmt.Errorf("failed to sync the metadata: %w", err)
 	}
-	ss.Stop()
 
-	if v, ok := k.getSelectedK8sVersion("Select the k8s version for Managed Cluster", listOfK8sVersions); !ok {
-		k.l.Error("Failed to get the k8s version")
-		os.Exit(1)
-	} else {
-		m.K8sVersion = v
+	v, err := k.getSelectedK8sVersion("Select the k8s version for Managed Cluster", listOfK8sVersions)
+	if err != nil {
+		return fmt.Errorf("failed to get the k8s version: %w", err)
 	}
+	m.K8sVersion = v
+	return nil
 }
 
-func (k *KsctlCommand) metadataSummary(meta controller.Metadata) {
+func (k *KsctlCommand) metadataSummary(meta controller.Metadata, pools []config.WorkerPool, monthlyCost float64, costCurrency string) {
 	// Use the new interactive cluster summary
-	cli.NewBlueprintUI(os.Stdout).RenderClusterBlueprint(meta)
+	bp := make([]cli.BlueprintWorkerPool, 0, len(pools))
+	for _, p := range pools {
+		bp = append(bp, cli.BlueprintWorkerPool{Name: p.Name, InstanceType: p.InstanceType, Count: p.Count, Scheduling: poolScheduling(p)})
+	}
+	cli.NewBlueprintUI(os.Stdout).WithMonthlyCost(monthlyCost, costCurrency).WithWorkerPools(bp).RenderClusterBlueprint(meta)
 }
 
 func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedCNI addons.ClusterAddons, defaultOptionManaged string, ksctlCNI addons.ClusterAddons, defaultOptionKsctl string) (addons.ClusterAddons, error) {
@@ -239,6 +278,9 @@ func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedC
 			cc,
 			cli.WithDefaultValue(defaultOpt),
 		)
+		if isBack(err) {
+			return addons.ClusterAddon{}, err
+		}
 		if err != nil {
 			return addons.ClusterAddon{}, errors.WrapError(
 				errors.ErrInvalidUserInput,
@@ -275,7 +317,9 @@ func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedC
 		if errF != nil { // Skip further processing if error
 			k.l.Warn(k.Ctx, "Failed to get the Flannel version list", "Reason", errF)
 		} else {
-			if v, err := k.menuDriven.DropDownList("Select the flannel version", vers, cli.WithDefaultValue(vers[0])); err != nil {
+			if v, err := k.menuDriven.DropDownList("Select the flannel version", vers, cli.WithDefaultValue(vers[0])); isBack(err) {
+				return nil, err
+			} else if err != nil {
 				k.l.Error("Failed to get the flannel version", "Reason", err)
 			} else {
 				k.l.Debug(k.Ctx, "Selected flannel version", "Version", v)
@@ -292,7 +336,9 @@ func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedC
 		if errC != nil { // Skip further processing if error
 			k.l.Warn(k.Ctx, "Failed to get the Cilium version list", "Reason", errC)
 		} else {
-			if v, err := k.menuDriven.DropDownList("Select the cilium version", vers, cli.WithDefaultValue(vers[0])); err != nil {
+			if v, err := k.menuDriven.DropDownList("Select the cilium version", vers, cli.WithDefaultValue(vers[0])); isBack(err) {
+				return nil, err
+			} else if err != nil {
 				k.l.Error("Failed to get the cilium version", "Reason", err)
 			} else {
 				k.l.Debug(k.Ctx, "Selected cilium version", "Version", v)
@@ -305,6 +351,9 @@ func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedC
 		// Get the Cilium Specific options
 		// where 2 modes are there one if guided and another is advance
 		ciliumMode, err := k.menuDriven.DropDownList("Select the cilium mode", []string{"guided", "advanced", "ksctl default"}, cli.WithDefaultValue("ksctl default"))
+		if isBack(err) {
+			return nil, err
+		}
 		if err != nil {
 			return nil, errors.WrapError(
 				errors.ErrInvalidUserInput,
@@ -322,6 +371,9 @@ func (k *KsctlCommand) handleCNI(metaClient *controllerMeta.Controller, managedC
 			}
 
 			selectedOption, err := k.menuDriven.MultiSelect("Select the cilium guided setup", input)
+			if isBack(err) {
+				return nil, err
+			}
 			if err != nil {
 				return nil, errors.WrapError(
 					errors.ErrInvalidUserInput,
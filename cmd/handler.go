@@ -20,6 +20,14 @@ func (k *KsctlCommand) CommandMapping() error {
 	c := k.Cluster()
 	cr := k.Configure()
 	a := k.Addons()
+	co := k.Cost()
+	pl := k.Plan()
+	dr := k.Drafts()
+	ps := k.Presets()
+	po := k.Pool()
+	sc := k.Schedule()
+	sr := k.Scheduler()
+	fl := k.Fleet()
 
 	cli.RegisterCommand(
 		k.root,
@@ -28,18 +36,33 @@ func (k *KsctlCommand) CommandMapping() error {
 		k.SelfUpdate(),
 		k.ShellCompletion(),
 		cr,
+		co,
+		pl,
+		dr,
+		ps,
+		sr,
+		fl,
 	)
 	cli.RegisterCommand(
 		c,
 		a,
 		k.Create(),
+		k.Clone(),
 		k.Delete(),
+		k.Reap(),
+		k.Protect(),
+		k.Unprotect(),
+		k.Label(),
 		k.List(),
 		k.Get(),
 		k.Connect(),
 		k.ScaleUp(),
 		k.ScaleDown(),
+		po,
+		sc,
 		k.Summary(),
+		k.ClusterCost(),
+		k.ClusterCarbon(),
 	)
 
 	cli.RegisterCommand(
@@ -47,6 +70,54 @@ func (k *KsctlCommand) CommandMapping() error {
 		k.ConfigureStorage(),
 		k.ConfigureCloud(),
 		k.ConfigureTelemetry(),
+		k.ConfigureBudget(),
+		k.ConfigureCurrency(),
+	)
+
+	cli.RegisterCommand(
+		co,
+		k.CostBudget(),
+	)
+
+	cli.RegisterCommand(
+		pl,
+		k.PlanCompare(),
+	)
+
+	cli.RegisterCommand(
+		dr,
+		k.DraftsList(),
+		k.DraftsShow(),
+		k.DraftsDelete(),
+		k.DraftsExport(),
+	)
+
+	cli.RegisterCommand(
+		ps,
+		k.PresetsList(),
+		k.PresetsShow(),
+		k.PresetsCreateFrom(),
+	)
+
+	cli.RegisterCommand(
+		po,
+		k.PoolAdd(),
+		k.PoolScale(),
+		k.PoolRemove(),
+	)
+
+	cli.RegisterCommand(
+		sc,
+		k.ScheduleSet(),
+		k.ScheduleShow(),
+		k.ScheduleClear(),
+	)
+
+	cli.RegisterCommand(
+		sr,
+		k.SchedulerRun(),
+		k.SchedulerStop(),
+		k.SchedulerJournal(),
 	)
 
 	cli.RegisterCommand(
@@ -55,5 +126,10 @@ func (k *KsctlCommand) CommandMapping() error {
 		k.DisableAddon(),
 	)
 
+	cli.RegisterCommand(
+		fl,
+		k.FleetDrift(),
+	)
+
 	return nil
 }
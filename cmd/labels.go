@@ -0,0 +1,188 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/telemetry"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// telemetryOptOutLabel set to off stops the telemetry events about the cluster
+const telemetryOptOutLabel = "ksctl.com/telemetry"
+
+// ownerGroup groups the cost rollup by the owner instead of a label
+const ownerGroup = "owner"
+
+// parseLabelChanges parses the key=value labels to set and the key- labels to remove
+func parseLabelChanges(args []string) (map[string]string, []string, error) {
+	set, remove := map[string]string{}, []string{}
+	for _, a := range args {
+		if key, ok := strings.CutSuffix(a, "-"); ok && !strings.Contains(a, "=") {
+			if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+				return nil, nil, fmt.Errorf("label key %q is invalid: %s", key, strings.Join(errs, "; "))
+			}
+			remove = append(remove, key)
+			continue
+		}
+		v, err := parseLabels(a)
+		if err != nil {
+			return nil, nil, err
+		}
+		maps.Copy(set, v)
+	}
+	return set, remove, nil
+}
+
+// formatLabels is the sorted key=value list of the labels
+func formatLabels(l map[string]string) string {
+	res := make([]string, 0, len(l))
+	for _, key := range slices.Sorted(maps.Keys(l)) {
+		res = append(res, key+"="+l[key])
+	}
+	return strings.Join(res, ",")
+}
+
+// selectClusters returns the clusters whose labels match the selector, e.g. env=staging,team!=payments
+func selectClusters(clusters []provider.ClusterData, records *config.ClusterRecords, selector string) ([]provider.ClusterData, error) {
+	if len(selector) == 0 {
+		return clusters, nil
+	}
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+	}
+
+	res := []provider.ClusterData{}
+	for _, c := range clusters {
+		var l labels.Set
+		if rec, ok := records.Get(clusterRecordKey(c)); ok {
+			l = rec.Labels
+		}
+		if sel.Matches(l) {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+// sendClusterTelemetry sends the event about the cluster unless it is labelled to opt out of the telemetry,
+// nothing is sent when the labels can't be read
+func (k *KsctlCommand) sendClusterTelemetry(m controller.Metadata, event telemetry.TelemetryEvent, data telemetry.TelemetryMeta) error {
+	records, err := k.readClusterRecords()
+	if err != nil {
+		return fmt.Errorf("unable to read the telemetry opt out of the cluster: %w", err)
+	}
+	if rec, ok := records.Get(metadataRecordKey(m)); ok && rec.Labels[telemetryOptOutLabel] == "off" {
+		k.l.Debug(k.Ctx, "Skipping the telemetry as the cluster opted out", "cluster", m.ClusterName, "event", event)
+		return nil
+	}
+	return k.telemetry.Send(k.Ctx, k.l, event, data)
+}
+
+func (k *KsctlCommand) showClusterLabels(c provider.ClusterData, rec *config.ClusterRecord) {
+	if rec == nil {
+		rec = &config.ClusterRecord{}
+	}
+	k.l.Table(k.Ctx, []string{"Attributes", "Values"}, [][]string{
+		{"ClusterName", c.Name},
+		{"Owner", rec.Owner},
+		{"Labels", formatLabels(rec.Labels)},
+		{"Notes", rec.Notes},
+	})
+}
+
+func (k *KsctlCommand) Label() *cobra.Command {
+	owner, notes := "", ""
+
+	cmd := &cobra.Command{
+		Use: "label <cluster> [key=value|key-]...",
+		Example: `
+ksctl cluster label demo
+ksctl cluster label demo team=payments env=staging
+ksctl cluster label demo env- --owner alice@example.com --notes "load tests of the checkout"
+ksctl cluster label demo ksctl.com/telemetry=off
+`,
+		Short: "Use to set the labels, owner and notes of a cluster",
+		Long: "It is used to set or remove (key-) the labels of a cluster along with its owner and free text notes, " +
+			"they are shown by list and get, can be selected with --selector and group the cost report. " +
+			"The label " + telemetryOptOutLabel + "=off stops the telemetry about the cluster. " +
+			"They are kept with the cluster records in the configured state store, so every machine using the store sees them",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			set, remove, err := parseLabelChanges(args[1:])
+			if err != nil {
+				k.l.Error("Invalid labels", "Reason", err)
+				os.Exit(1)
+			}
+
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+			c, ok := k.selectClusterByName("Select the cluster to label", args[0], clusters)
+			if !ok {
+				os.Exit(1)
+			}
+
+			changed := len(set) != 0 || len(remove) != 0 || cmd.Flags().Changed("owner") || cmd.Flags().Changed("notes")
+			if !changed {
+				rec, _ := k.loadClusterRecords().Get(clusterRecordKey(c))
+				k.showClusterLabels(c, rec)
+				return
+			}
+
+			var updated config.ClusterRecord
+			if err := k.updateClusterRecord(k.clusterMetadata(c), func(r *config.ClusterRecord) {
+				if r.Labels == nil {
+					r.Labels = map[string]string{}
+				}
+				maps.Copy(r.Labels, set)
+				for _, key := range remove {
+					delete(r.Labels, key)
+				}
+				if cmd.Flags().Changed("owner") {
+					r.Owner = strings.TrimSpace(owner)
+				}
+				if cmd.Flags().Changed("notes") {
+					r.Notes = strings.TrimSpace(notes)
+				}
+				updated = *r
+			}); err != nil {
+				k.l.Error("Failed to update the cluster records", "Reason", err)
+				os.Exit(1)
+			}
+
+			k.showClusterLabels(c, &updated)
+			k.l.Success(k.Ctx, "Updated the cluster labels", "cluster", c.Name)
+		},
+	}
+
+	cmd.Flags().StringVar(&owner, "owner", "", "Owner of the cluster, empty to clear it")
+	cmd.Flags().StringVar(&notes, "notes", "", "Free text notes about the cluster, empty to clear them")
+
+	return cmd
+}
@@ -17,7 +17,11 @@ package cmd
 import (
 	"context"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/errors"
@@ -29,12 +33,31 @@ import (
 	controllerCommon "github.com/ksctl/ksctl/v2/pkg/handler/cluster/common"
 )
 
+// clusterListEntry is a cluster in the json output of list
+type clusterListEntry struct {
+	Name              string                  `json:"name"`
+	CloudProvider     consts.KsctlCloud       `json:"cloudProvider"`
+	ClusterType       consts.KsctlClusterType `json:"clusterType"`
+	Region            string                  `json:"region"`
+	BootstrapProvider consts.KsctlKubernetes  `json:"bootstrapProvider"`
+	K8sVersion        string                  `json:"k8sVersion"`
+	Owner             string                  `json:"owner,omitempty"`
+	Labels            map[string]string       `json:"labels,omitempty"`
+	Notes             string                  `json:"notes,omitempty"`
+	Protected         bool                    `json:"protected,omitempty"`
+	ExpiresAt         *time.Time              `json:"expiresAt,omitempty"`
+}
+
 func (k *KsctlCommand) List() *cobra.Command {
+	output := ""
+	selector := ""
 
 	cmd := &cobra.Command{
 		Use: "list",
 		Example: `
 ksctl list --help
+ksctl list --selector env=staging
+ksctl list --selector 'team in (payments,search),env!=prod' --output json
 `,
 		Short: "Use to list all the clusters",
 		Long:  "It is used to list all the clusters created by the user",
@@ -49,22 +72,43 @@ ksctl list --help
 				k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
 			}
 
+			records, ok := k.selectorClusterRecords(selector)
+			if !ok {
+				os.Exit(1)
+			}
+			clusters, err = selectClusters(clusters, records, selector)
+			if err != nil {
+				k.l.Error("Failed to filter the clusters", "Reason", err)
+				os.Exit(1)
+			}
+
+			if output == cli.OutputJson {
+				if err := printJson(clusterListEntries(clusters, records)); err != nil {
+					k.l.Error("Failed to print the clusters", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
 			if len(clusters) == 0 {
 				k.l.Print(k.Ctx, "No clusters found")
 				return
 			}
 
-			HandleTableOutputListAll(k.Ctx, k.l, clusters)
+			HandleTableOutputListAll(k.Ctx, k.l, clusters, records)
 		},
 	}
 
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "Label selector of the clusters, e.g. env=staging,team!=payments")
+
 	return cmd
 }
 
 func (k *KsctlCommand) fetchAllClusters() ([]provider.ClusterData, error) {
 	m := controller.Metadata{}
-	if v, ok := k.getSelectedStorageDriver(); !ok {
-		return nil, errors.NewError(errors.ErrInvalidStorageProvider)
+	if v, err := k.getSelectedStorageDriver(); err != nil {
+		return nil, errors.WrapError(errors.ErrInvalidStorageProvider, err)
 	} else {
 		m.StateLocation = v
 	}
@@ -90,8 +134,9 @@ func (k *KsctlCommand) fetchAllClusters() ([]provider.ClusterData, error) {
 	return clusters, nil
 }
 
-func HandleTableOutputListAll(ctx context.Context, l logger.Logger, data []provider.ClusterData) {
-	headers := []string{"Name", "Type", "Cloud", "Region", "BootstrapProvider"}
+func HandleTableOutputListAll(ctx context.Context, l logger.Logger, data []provider.ClusterData, records *config.ClusterRecords) {
+	headers := []string{"Name", "Type", "Cloud", "Region", "BootstrapProvider", "Expires", "Owner", "Labels"}
+	now := time.Now()
 	var dataToPrint [][]string = make([][]string, 0, len(data))
 	for _, v := range data {
 		var row []string
@@ -105,8 +150,101 @@ func HandleTableOutputListAll(ctx context.Context, l logger.Logger, data []provi
 			row,
 			string(v.K8sDistro),
 		)
+		rec, _ := records.Get(clusterRecordKey(v))
+		row = append(row, remainingTime(rec, now))
+		if rec != nil {
+			row = append(row, rec.Owner, formatLabels(rec.Labels))
+		} else {
+			row = append(row, "", "")
+		}
 		dataToPrint = append(dataToPrint, row)
 	}
 
 	l.Table(ctx, headers, dataToPrint)
 }
+
+func clusterListEntries(clusters []provider.ClusterData, records *config.ClusterRecords) []clusterListEntry {
+	res := make([]clusterListEntry, 0, len(clusters))
+	for _, c := range clusters {
+		e := clusterListEntry{
+			Name:              c.Name,
+			CloudProvider:     c.CloudProvider,
+			ClusterType:       c.ClusterType,
+			Region:            c.Region,
+			BootstrapProvider: c.K8sDistro,
+			K8sVersion:        c.K8sVersion,
+		}
+		if rec, ok := records.Get(clusterRecordKey(c)); ok {
+			e.Owner, e.Labels, e.Notes = rec.Owner, rec.Labels, rec.Notes
+			e.Protected, e.ExpiresAt = rec.Protected, rec.ExpiresAt
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+// clusterMetadata is the metadata to operate on the existing cluster
+func (k *KsctlCommand) clusterMetadata(c provider.ClusterData) controller.Metadata {
+	return controller.Metadata{
+		ClusterName:   c.Name,
+		ClusterType:   c.ClusterType,
+		Provider:      c.CloudProvider,
+		Region:        c.Region,
+		StateLocation: k.KsctlConfig.PreferedStateStore,
+		K8sDistro:     c.K8sDistro,
+		K8sVersion:    c.K8sVersion,
+		EtcdVersion:   c.EtcdVersion,
+		NoCP:          c.NoCP,
+		NoWP:          c.NoWP,
+		NoDS:          c.NoDS,
+		NoMP:          c.NoMgt,
+	}
+}
+
+// selectCluster asks the user to pick one of the clusters
+func (k *KsctlCommand) selectCluster(prompt string, clusters []provider.ClusterData) (provider.ClusterData, bool) {
+	if len(clusters) == 0 {
+		k.l.Print(k.Ctx, "No clusters found")
+		return provider.ClusterData{}, false
+	}
+
+	options := make(map[string]string, len(clusters))
+	for idx, c := range clusters {
+		options[makeHumanReadableList(c)] = strconv.Itoa(idx)
+	}
+	v, err := k.menuDriven.DropDown(prompt, options)
+	if err != nil {
+		k.l.Error("Failed to get userinput", "Reason", err)
+		return provider.ClusterData{}, false
+	}
+	idx, _ := strconv.Atoi(v)
+	return clusters[idx], true
+}
+
+// selectClusterByName returns the cluster with the name, the user picks one when
+// clusters with the name exist in more than one cloud or region
+func (k *KsctlCommand) selectClusterByName(prompt, name string, clusters []provider.ClusterData) (provider.ClusterData, bool) {
+	matches := []provider.ClusterData{}
+	for _, c := range clusters {
+		if c.Name == name {
+			matches = append(matches, c)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		k.l.Error("Cluster not found", "name", name)
+		return provider.ClusterData{}, false
+	case 1:
+		return matches[0], true
+	}
+	return k.selectCluster(prompt, matches)
+}
+
+// clusterFromArgs returns the cluster named in the args otherwise asks the user to pick one
+func (k *KsctlCommand) clusterFromArgs(prompt string, args []string, clusters []provider.ClusterData) (provider.ClusterData, bool) {
+	if len(args) != 0 {
+		return k.selectClusterByName(prompt, args[0], clusters)
+	}
+	return k.selectCluster(prompt, clusters)
+}
@@ -0,0 +1,556 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/logger"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+// planRole is the shape of the nodes for a role independent of the cloud provider
+type planRole struct {
+	Role     string                   `json:"role"`
+	Count    int                      `json:"count"`
+	VCpus    int                      `json:"vcpus"`
+	Memory   int                      `json:"memory"`
+	Category provider.MachineCategory `json:"category"`
+	// Arch is the cpu architecture of the nodes, empty matches any
+	Arch provider.MachineArch `json:"arch,omitempty"`
+}
+
+type planBlueprint struct {
+	ClusterType consts.KsctlClusterType `json:"clusterType"`
+	Roles       []planRole              `json:"roles"`
+}
+
+type planPlacement struct {
+	CloudProvider consts.KsctlCloud `json:"cloudProvider"`
+	Region        string            `json:"region"`
+	RegionName    string            `json:"regionName"`
+	Monthly       float64           `json:"monthly"`
+	Currency      string            `json:"currency"`
+	Operational   float64           `json:"operationalKgCo2eq"`
+	Embodied      float64           `json:"embodiedKgCo2eq"`
+	// EmbodiedPartial is set when some of the instance types have no embodied emissions data
+	EmbodiedPartial bool       `json:"embodiedPartial,omitempty"`
+	Roles           []roleCost `json:"roles"`
+}
+
+type planComparison struct {
+	Blueprint  planBlueprint   `json:"blueprint"`
+	Placements []planPlacement `json:"placements"`
+}
+
+// planClouds are the providers which can be compared
+var planClouds = []consts.KsctlCloud{consts.CloudAws, consts.CloudAzure}
+
+// maxConcurrentPlacements bounds the regions priced at the same time per cloud provider
+const maxConcurrentPlacements = 8
+
+func (k *KsctlCommand) Plan() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "plan",
+		Example: `
+ksctl plan --help
+`,
+		Short: "Use to plan the placement of clusters",
+		Long:  "It is used to plan where a cluster should be placed before creating it",
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) PlanCompare() *cobra.Command {
+	output := ""
+	fromCluster := ""
+	regions := []string{}
+	top := 0
+
+	cmd := &cobra.Command{
+		Use: "compare",
+		Example: `
+ksctl plan compare
+ksctl plan compare --from demo
+ksctl plan compare --from demo --regions eastus,us-east-1 --output json
+`,
+		Short: "Use to compare a blueprint across cloud providers",
+		Long:  "It is used to match the node roles of a blueprint to the closest instance types of every cloud provider by vCPU, memory, category and cpu architecture, and compare the monthly cost and emissions per provider and region",
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				bp planBlueprint
+				ok bool
+			)
+			if len(fromCluster) != 0 {
+				bp, ok = k.planBlueprintFromCluster(fromCluster)
+			} else {
+				bp, ok = k.planBlueprintFromUser()
+			}
+			if !ok {
+				os.Exit(1)
+			}
+
+			res := planComparison{
+				Blueprint:  bp,
+				Placements: k.comparePlacements(bp, regions),
+			}
+			if len(res.Placements) == 0 {
+				k.l.Error("No region could fit the blueprint", "hint", "configure the cloud credentials with $ksctl configure cloud")
+				os.Exit(1)
+			}
+
+			if top > 0 {
+				res.Placements = topPlacementsPerCloud(res.Placements, top)
+			}
+
+			if output == cli.OutputJson {
+				if err := printJson(res); err != nil {
+					k.l.Error("Failed to print the comparison", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			handleTableOutputPlanCompare(k.Ctx, k.l, res)
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+	cmd.Flags().StringVar(&fromCluster, "from", "", "Use the nodes of an existing cluster as the blueprint")
+	cmd.Flags().StringSliceVar(&regions, "regions", nil, "Only compare these regions")
+	cmd.Flags().IntVar(&top, "top", 5, "Number of cheapest regions to show per cloud provider (0 for all)")
+
+	return cmd
+}
+
+func (k *KsctlCommand) planBlueprintFromCluster(name string) (planBlueprint, bool) {
+	clusters, err := k.fetchAllClusters()
+	if err != nil {
+		k.l.Error("Error in fetching the clusters", "Error", err)
+		return planBlueprint{}, false
+	}
+
+	idx := slices.IndexFunc(clusters, func(c provider.ClusterData) bool {
+		return c.Name == name && c.CloudProvider != consts.CloudLocal
+	})
+	if idx < 0 {
+		k.l.Error("Cloud cluster not found", "name", name)
+		return planBlueprint{}, false
+	}
+	cluster := clusters[idx]
+
+	pricing := k.newInstancePricing()
+	bp := planBlueprint{ClusterType: cluster.ClusterType}
+	for _, n := range clusterNodes(cluster) {
+		vm, err := pricing.instance(cluster, n.Sku)
+		if err != nil {
+			k.l.Error("Failed to get the instance type", "sku", n.Sku, "Reason", err)
+			return planBlueprint{}, false
+		}
+		bp.Roles = append(bp.Roles, planRole{
+			Role:     n.Role,
+			Count:    n.Count,
+			VCpus:    vm.VCpus,
+			Memory:   vm.Memory,
+			Category: vm.Category,
+			Arch:     vm.CpuArch,
+		})
+	}
+
+	return bp, true
+}
+
+func (k *KsctlCommand) planBlueprintFromUser() (planBlueprint, bool) {
+	clusterType, err := k.getSelectedClusterType()
+	if err != nil {
+		k.l.Error("Failed to get userinput", "Reason", err)
+		return planBlueprint{}, false
+	}
+
+	bp := planBlueprint{ClusterType: clusterType}
+
+	type roleInput struct {
+		role     string
+		count    int
+		category provider.MachineCategory
+		vcpus    int
+		memory   int
+	}
+
+	inputs := []roleInput{
+		{role: roleManagedNodes, count: 1, vcpus: 2, memory: 4},
+	}
+	if clusterType == consts.ClusterTypeSelfMang {
+		inputs = []roleInput{
+			{role: roleControlPlane, count: 3, category: provider.ComputeIntensive, vcpus: 2, memory: 4},
+			{role: roleWorkerPlane, count: 1, vcpus: 2, memory: 4},
+			{role: roleDataStore, count: 3, category: provider.MemoryIntensive, vcpus: 2, memory: 4},
+			{role: roleLoadBalancer, count: 1, category: provider.GeneralPurpose, vcpus: 2, memory: 4},
+		}
+	}
+
+	positive := atLeast(1)
+
+	roles := make([]string, 0, len(inputs))
+	for _, in := range inputs {
+		roles = append(roles, in.role)
+	}
+	archs, err := k.handleArchSelection(&controller.Metadata{}, roles...)
+	if err != nil {
+		k.l.Error("Failed to get userinput", "Reason", err)
+		return planBlueprint{}, false
+	}
+
+	for _, in := range inputs {
+		r := planRole{Role: in.role, Count: 1, Category: in.category, Arch: archs[in.role]}
+
+		if in.role != roleLoadBalancer {
+			v, err := k.getCounterValue(fmt.Sprintf("Enter the number of %s nodes", in.role), positive, in.count)
+			if err != nil {
+				k.l.Error("Failed to get userinput", "Reason", err)
+				return planBlueprint{}, false
+			}
+			r.Count = v
+		}
+
+		if len(r.Category) == 0 {
+			k.l.Note(k.Ctx, "Select the instance category", "role", in.role)
+			if r.Category, err = k.handleInstanceCategorySelection(); err != nil {
+				k.l.Error("Failed to get userinput", "Reason", err)
+				return planBlueprint{}, false
+			}
+		}
+
+		v, err := k.getCounterValue(fmt.Sprintf("Enter the vCPUs per %s node", in.role), positive, in.vcpus)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return planBlueprint{}, false
+		}
+		r.VCpus = v
+
+		v, err = k.getCounterValue(fmt.Sprintf("Enter the memory (GB) per %s node", in.role), positive, in.memory)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			return planBlueprint{}, false
+		}
+		r.Memory = v
+
+		bp.Roles = append(bp.Roles, r)
+	}
+
+	return bp, true
+}
+
+// closestInstance returns the instance type with the least headroom over the requested vCPUs and memory,
+// the cheaper one wins when two are equally close
+func closestInstance(vms provider.InstancesRegionOutput, want planRole) (provider.InstanceRegionOutput, bool) {
+	var (
+		best      provider.InstanceRegionOutput
+		bestScore float64
+		found     bool
+	)
+
+	for _, v := range vms {
+		if len(want.Arch) != 0 && v.CpuArch != want.Arch {
+			continue
+		}
+		if len(want.Category) != 0 && v.Category != want.Category {
+			continue
+		}
+		if v.VCpus < want.VCpus || v.Memory < want.Memory {
+			continue
+		}
+
+		score := float64(v.VCpus-want.VCpus)/float64(max(want.VCpus, 1)) +
+			float64(v.Memory-want.Memory)/float64(max(want.Memory, 1))
+
+		if !found || score < bestScore || (score == bestScore && v.GetCost() < best.GetCost()) {
+			best, bestScore, found = v, score, true
+		}
+	}
+
+	return best, found
+}
+
+func (p *instancePricing) placement(bp planBlueprint, cluster provider.ClusterData, region provider.RegionOutput) (planPlacement, error) {
+	res := planPlacement{
+		CloudProvider: cluster.CloudProvider,
+		Region:        region.Sku,
+		RegionName:    region.Name,
+	}
+
+	if _, ok := carbonIntensity(region); !ok && region.Emission != nil {
+		return res, fmt.Errorf("unsupported carbon intensity unit %q of the region %s", region.Emission.Unit, region.Sku)
+	}
+
+	vms, err := p.instances(cluster)
+	if err != nil {
+		return res, err
+	}
+
+	cost := clusterCost{Cluster: cluster}
+	for _, r := range bp.Roles {
+		vm, ok := closestInstance(vms, r)
+		if !ok {
+			return res, fmt.Errorf("no instance type matches %s with %d vCPUs and %d GB", r.Role, r.VCpus, r.Memory)
+		}
+
+		cost.Currency = vm.Price.Currency
+		monthly := float64(r.Count) * vm.GetCost()
+		cost.Total += monthly
+		cost.Roles = append(cost.Roles, roleCost{Role: r.Role, Sku: vm.Sku, Count: r.Count, Monthly: monthly})
+
+		res.Operational += operationalEmission(float64(r.Count)*monthlyEnergy(vm), region)
+		if e, ok := monthlyEmbodied(vm); ok {
+			res.Embodied += float64(r.Count) * e
+		} else {
+			res.EmbodiedPartial = true
+		}
+	}
+
+	if bp.ClusterType == consts.ClusterTypeMang {
+		o, err := p.managedOffering(cluster)
+		if err != nil {
+			return res, err
+		}
+		if o != nil {
+			cost.Total += o.GetCost()
+			cost.Roles = append(cost.Roles, roleCost{Role: roleManagedOffering, Sku: o.Sku, Count: 1, Monthly: o.GetCost()})
+		}
+	}
+
+	cost = cost.inDisplayCurrency()
+	res.Monthly, res.Currency, res.Roles = cost.Total, cost.Currency, cost.Roles
+
+	return res, nil
+}
+
+func (k *KsctlCommand) hasCloudCreds(cloud consts.KsctlCloud) bool {
+	var err error
+	switch cloud {
+	case consts.CloudAws:
+		_, err = k.loadAwsCredentials()
+	case consts.CloudAzure:
+		_, err = k.loadAzureCredentials()
+	}
+	return err == nil
+}
+
+func (k *KsctlCommand) comparePlacements(bp planBlueprint, onlyRegions []string) []planPlacement {
+	pricing := k.newInstancePricing()
+	res := []planPlacement{}
+
+	for _, cloud := range planClouds {
+		if !k.hasCloudCreds(cloud) {
+			k.l.Warn(k.Ctx, "Skipping the cloud provider as the credentials are not configured", "cloud", cloud)
+			continue
+		}
+		if err := pricing.loadCreds(cloud); err != nil {
+			k.l.Warn(k.Ctx, "Skipping the cloud provider as the credentials can't be loaded", "cloud", cloud, "Reason", err)
+			continue
+		}
+
+		base := provider.ClusterData{
+			Name:          "plan",
+			CloudProvider: cloud,
+			ClusterType:   bp.ClusterType,
+		}
+
+		ss := k.menuDriven.GetProgressAnimation()
+		ss.Start(fmt.Sprintf("Pricing the blueprint on %s", cloud))
+
+		regions, err := pricing.allRegions(base)
+		if err != nil {
+			ss.Stop()
+			k.l.Warn(k.Ctx, "Failed to list the regions", "cloud", cloud, "Reason", err)
+			continue
+		}
+
+		mu := sync.Mutex{}
+		sem := make(chan struct{}, maxConcurrentPlacements)
+		wg := sync.WaitGroup{}
+		skipped := 0
+		for _, r := range regions {
+			if len(onlyRegions) != 0 && !slices.Contains(onlyRegions, r.Sku) {
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				cluster := base
+				cluster.Region = r.Sku
+
+				p, err := pricing.placement(bp, cluster, r)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					k.l.Debug(k.Ctx, "Region can't fit the blueprint", "cloud", cloud, "region", r.Sku, "Reason", err)
+					skipped++
+					return
+				}
+				res = append(res, p)
+			}()
+		}
+		wg.Wait()
+		ss.Stop()
+
+		if skipped > 0 {
+			k.l.Note(k.Ctx, "Some regions can't fit the blueprint", "cloud", cloud, "count", skipped)
+		}
+	}
+
+	if !sortPlacements(res, currency.Default()) {
+		k.l.Warn(k.Ctx, "Prices are in different currencies without exchange rates, the placements are ranked per currency", "hint", "use $ksctl configure currency")
+	}
+
+	return res
+}
+
+// sortPlacements orders the placements by the monthly cost converted into a common currency,
+// when some of them can't be converted they are grouped by currency and false is returned
+func sortPlacements(res []planPlacement, conv *currency.Converter) bool {
+	common := strings.ToUpper(conv.Display)
+	if len(common) == 0 && len(res) != 0 {
+		common = res[0].Currency
+	}
+
+	type rankedPlacement struct {
+		planPlacement
+		monthly float64
+	}
+
+	converted := true
+	ranked := make([]rankedPlacement, len(res))
+	for i, p := range res {
+		v, err := conv.Rates.Convert(p.Monthly, p.Currency, common)
+		if err != nil {
+			converted = false
+			v = p.Monthly
+		}
+		ranked[i] = rankedPlacement{planPlacement: p, monthly: v}
+	}
+
+	slices.SortFunc(ranked, func(a, b rankedPlacement) int {
+		if !converted {
+			if c := strings.Compare(a.Currency, b.Currency); c != 0 {
+				return c
+			}
+		}
+		if c := cmp.Compare(a.monthly, b.monthly); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.CloudProvider)+a.Region, string(b.CloudProvider)+b.Region)
+	})
+
+	for i := range ranked {
+		res[i] = ranked[i].planPlacement
+	}
+	return converted
+}
+
+// topPlacementsPerCloud keeps the n cheapest placements of every cloud provider, the input is sorted by cost
+func topPlacementsPerCloud(placements []planPlacement, n int) []planPlacement {
+	seen := map[consts.KsctlCloud]int{}
+	return slices.DeleteFunc(placements, func(p planPlacement) bool {
+		seen[p.CloudProvider]++
+		return seen[p.CloudProvider] > n
+	})
+}
+
+func handleTableOutputPlanCompare(ctx context.Context, l logger.Logger, res planComparison) {
+	{
+		headers := []string{"Role", "Count", "vCPUs", "Memory", "Category", "Arch"}
+		rows := [][]string{}
+		for _, r := range res.Blueprint.Roles {
+			category := string(r.Category)
+			if len(category) == 0 {
+				category = "any"
+			}
+			arch := string(r.Arch)
+			if len(arch) == 0 {
+				arch = "any"
+			}
+			rows = append(rows, []string{r.Role, fmt.Sprint(r.Count), fmt.Sprint(r.VCpus), fmt.Sprintf("%d GB", r.Memory), category, arch})
+		}
+		l.Table(ctx, headers, rows)
+		fmt.Println()
+	}
+
+	headers := []string{"Cloud", "Region", "Monthly", "Operational", "Embodied", "InstanceTypes"}
+	rows := [][]string{}
+
+	// the cheapest is only highlighted when the prices are in the same currency
+	sameCurrency := !slices.ContainsFunc(res.Placements, func(p planPlacement) bool {
+		return p.Currency != res.Placements[0].Currency
+	})
+
+	lowestCarbon := 0
+	for i, p := range res.Placements {
+		if p.Operational < res.Placements[lowestCarbon].Operational {
+			lowestCarbon = i
+		}
+	}
+
+	for i, p := range res.Placements {
+		skus := []string{}
+		for _, r := range p.Roles {
+			skus = append(skus, fmt.Sprintf("%s=%s", r.Role, r.Sku))
+		}
+
+		monthly := currency.Format(p.Monthly, p.Currency)
+		if i == 0 && sameCurrency {
+			monthly = color.HiGreenString(monthly)
+		}
+		operational := fmt.Sprintf("%.2f kgCO2eq", p.Operational)
+		if i == lowestCarbon {
+			operational = color.HiGreenString(operational)
+		}
+		embodied := fmt.Sprintf("%.2f kgCO2eq", p.Embodied)
+		if p.EmbodiedPartial {
+			embodied += " (partial)"
+		}
+
+		rows = append(rows, []string{
+			string(p.CloudProvider),
+			fmt.Sprintf("%s (%s)", p.Region, p.RegionName),
+			monthly,
+			operational,
+			embodied,
+			strings.Join(skus, ", "),
+		})
+	}
+	l.Table(ctx, headers, rows)
+
+	l.Note(ctx, "Instance types are the closest match by vCPUs and memory within the category and cpu architecture")
+}
@@ -0,0 +1,740 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/selfmanaged"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// defaultPoolName is the pool of the worker nodes which are in none of the named pools
+const defaultPoolName = "default"
+
+// poolInstanceKey is the key of the instance type of the worker pool in the cluster spec
+func poolInstanceKey(name string) string {
+	return roleWorkerPlane + "/" + name
+}
+
+// workerPoolsOf returns the worker pools of the cluster with the nodes which still exist,
+// the default pool is first and has the nodes which are in none of the other pools
+func workerPoolsOf(c provider.ClusterData, rec *config.ClusterRecord) []config.WorkerPool {
+	exists := make(map[string]bool, len(c.WP))
+	for _, vm := range c.WP {
+		exists[vm.VMName] = true
+	}
+
+	def := config.WorkerPool{Name: defaultPoolName}
+	pools := []config.WorkerPool{}
+	assigned := map[string]bool{}
+	if rec != nil {
+		for _, p := range rec.WorkerPools {
+			if p.Name == defaultPoolName {
+				def.Labels, def.Taints = p.Labels, p.Taints
+				continue
+			}
+			p.Nodes = slices.DeleteFunc(slices.Clone(p.Nodes), func(n string) bool { return !exists[n] })
+			for _, n := range p.Nodes {
+				assigned[n] = true
+			}
+			pools = append(pools, p)
+		}
+	}
+
+	skus := []string{}
+	for _, vm := range c.WP {
+		if assigned[vm.VMName] {
+			continue
+		}
+		def.Nodes = append(def.Nodes, vm.VMName)
+		if !slices.Contains(skus, vm.VMSize) {
+			skus = append(skus, vm.VMSize)
+		}
+	}
+	def.InstanceType = strings.Join(skus, ",")
+
+	if len(def.Nodes) == 0 && len(pools) != 0 {
+		return pools
+	}
+	return append([]config.WorkerPool{def}, pools...)
+}
+
+func poolNames(pools []config.WorkerPool) []string {
+	names := make([]string, 0, len(pools))
+	for _, p := range pools {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// poolScheduling is the labels and the taints of the pool in a short form
+func poolScheduling(p config.WorkerPool) string {
+	res := []string{}
+	for _, k := range slices.Sorted(maps.Keys(p.Labels)) {
+		res = append(res, k+"="+p.Labels[k])
+	}
+	res = append(res, p.Taints...)
+	return strings.Join(res, ",")
+}
+
+func formatWorkerPool(p config.WorkerPool) string {
+	v := fmt.Sprintf("%s: %d X %s", p.Name, len(p.Nodes), p.InstanceType)
+	if s := poolScheduling(p); len(s) != 0 {
+		v += " [" + s + "]"
+	}
+	return v
+}
+
+func newPoolNameValidator(existing []string) func(string) error {
+	return func(name string) error {
+		if err := validateDNS1123Name(name); err != nil {
+			return err
+		}
+		if slices.Contains(existing, name) {
+			return fmt.Errorf("worker pool %s already exists", name)
+		}
+		return nil
+	}
+}
+
+func (k *KsctlCommand) askPoolName(existing []string) (string, error) {
+	return k.menuDriven.TextInput("Enter the name of the worker pool", cli.WithValidator(newPoolNameValidator(existing)))
+}
+
+// askPoolScheduling asks the labels and the taints of the nodes of the pool
+func (k *KsctlCommand) askPoolScheduling(name string) (map[string]string, []string, error) {
+	l, err := k.menuDriven.TextInput(
+		fmt.Sprintf("Enter the labels of the %s pool as key=value, comma separated (empty for none)", name),
+		cli.WithValidator(func(v string) error {
+			_, err := parseLabels(v)
+			return err
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, err := k.menuDriven.TextInput(
+		fmt.Sprintf("Enter the taints of the %s pool as key=value:Effect, comma separated (empty for none)", name),
+		cli.WithValidator(func(v string) error {
+			_, err := parseTaints(v)
+			return err
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labels, _ := parseLabels(l)
+	taints, _ := parseTaints(t)
+	return labels, taints, nil
+}
+
+// askWorkerPools lets the worker nodes of the worker plane step be the default pool
+// and adds the pools with their own instance type
+func (w *createWizard) askWorkerPools() error {
+	w.pools = nil
+	w.poolVMs = map[string]provider.InstanceRegionOutput{}
+
+	ok, err := w.k.menuDriven.Confirmation("Do you want worker pools with their own instance type, labels and taints", cli.WithDefaultValue("no"))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	def := config.WorkerPool{Name: defaultPoolName}
+	if def.Labels, def.Taints, err = w.k.askPoolScheduling(defaultPoolName); err != nil {
+		return err
+	}
+	w.pools = append(w.pools, def)
+
+	for {
+		more, err := w.k.menuDriven.Confirmation("Do you want to add a worker pool", cli.WithDefaultValue("yes"))
+		if err != nil {
+			return err
+		}
+		if !more {
+			return nil
+		}
+
+		name, err := w.k.askPoolName(poolNames(w.pools))
+		if err != nil {
+			return err
+		}
+		p := config.WorkerPool{Name: name}
+		category := provider.Unknown
+		if w.meta.Provider != consts.CloudLocal {
+			if category, err = w.k.handleInstanceCategorySelection(); err != nil {
+				return err
+			}
+		}
+		vm, err := w.k.handleInstanceTypeSelection(w.metaClient, w.meta, category, w.archs[roleWorkerPlane], "Select instance_type for the "+p.Name+" pool")
+		if err != nil {
+			return err
+		}
+		p.InstanceType = vm.Sku
+		if p.Count, err = w.askCount("Enter the number of nodes of the "+p.Name+" pool", inRange(1, maxNodesPerPool), 1, "worker nodes"); err != nil {
+			return err
+		}
+		if p.Labels, p.Taints, err = w.k.askPoolScheduling(p.Name); err != nil {
+			return err
+		}
+
+		w.pools = append(w.pools, p)
+		w.poolVMs[p.Name] = vm
+	}
+}
+
+// workerPools are the pools of the wizard, the default one is made of the worker plane nodes
+func (w *createWizard) workerPools() []config.WorkerPool {
+	if len(w.pools) == 0 {
+		return nil
+	}
+	pools := slices.Clone(w.pools)
+	pools[0].InstanceType = w.meta.WorkerPlaneNodeType
+	pools[0].Count = w.meta.NoWP
+	return pools
+}
+
+// workerPoolsCost is the monthly cost of the pools other than the default one
+func (w *createWizard) workerPoolsCost() float64 {
+	total := 0.0
+	for _, p := range w.workerPools() {
+		if vm, ok := w.poolVMs[p.Name]; ok && p.Name != defaultPoolName {
+			total += float64(p.Count) * vm.GetCost()
+		}
+	}
+	return total
+}
+
+// findSelfManagedCluster returns the current state of the cluster
+func (k *KsctlCommand) findSelfManagedCluster(m controller.Metadata) (provider.ClusterData, bool) {
+	clusters, err := k.fetchSelfManagedClusters()
+	if err != nil {
+		k.l.Error("Error in fetching the clusters", "Error", err)
+		return provider.ClusterData{}, false
+	}
+	for _, c := range clusters {
+		if clusterRecordKey(c) == metadataRecordKey(m) {
+			return c, true
+		}
+	}
+	k.l.Error("Cluster not found", "name", m.ClusterName)
+	return provider.ClusterData{}, false
+}
+
+// addWorkerNodes adds the worker nodes of the instance type and returns the cluster with the names of the new nodes
+func (k *KsctlCommand) addWorkerNodes(c provider.ClusterData, sku string, count int) (provider.ClusterData, []string) {
+	m := k.clusterMetadata(c)
+	m.NoWP = c.NoWP + count
+	m.WorkerPlaneNodeType = sku
+
+	sc, err := selfmanaged.NewController(k.Ctx, k.l, &controller.Client{Metadata: m})
+	if err != nil {
+		k.l.Error("Error in creating the controller", "Error", err)
+		os.Exit(1)
+	}
+	if err := sc.AddWorkerNodes(); err != nil {
+		k.l.Error("Error in adding the worker nodes", "Error", err)
+		os.Exit(1)
+	}
+
+	updated, ok := k.findSelfManagedCluster(m)
+	if !ok {
+		os.Exit(1)
+	}
+
+	before := map[string]bool{}
+	for _, vm := range c.WP {
+		before[vm.VMName] = true
+	}
+	added := []string{}
+	for _, vm := range updated.WP {
+		if !before[vm.VMName] {
+			added = append(added, vm.VMName)
+		}
+	}
+	return updated, added
+}
+
+// removeWorkerNodes drains and removes the newest worker nodes of the cluster
+func (k *KsctlCommand) removeWorkerNodes(c provider.ClusterData, nodes []string, drainTimeout time.Duration, metaClient *controllerMeta.Controller) {
+	if err := removableWorkerNodes(c.WP, nodes); err != nil {
+		k.l.Error("Invalid worker nodes to remove", "Reason", err)
+		os.Exit(1)
+	}
+	m := k.clusterMetadata(c)
+	if c.NoWP == len(nodes) {
+		k.guardProtected(m, "remove all the worker nodes", false)
+	}
+
+	d, err := k.clusterNodeClient(k.Ctx, m)
+	if err != nil {
+		k.l.Error("Failed to connect to the cluster to drain the nodes", "Reason", err)
+		os.Exit(1)
+	}
+	created, err := d.nodeCreation(k.Ctx)
+	if err != nil {
+		k.l.Error("Failed to get the nodes of the cluster", "Reason", err)
+		os.Exit(1)
+	}
+	evicted := k.previewEvictions(d, nodes, created)
+
+	cost, code := k.workerNodesCost(metaClient, c.Region, c.WP[len(c.WP)-len(nodes):])
+	k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will -%s <%s>", currency.Format(cost, code), strings.Join(nodes, ",")))
+
+	if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with the removal of the worker nodes", cli.WithDefaultValue("no")); !ok {
+		os.Exit(1)
+	}
+
+	k.drainNodes(d, nodes, created, evicted, drainTimeout)
+
+	m.NoWP = c.NoWP - len(nodes)
+	sc, err := selfmanaged.NewController(k.Ctx, k.l, &controller.Client{Metadata: m})
+	if err != nil {
+		k.l.Error("Error in creating the controller", "Error", err)
+		os.Exit(1)
+	}
+	if err := sc.DeleteWorkerNodes(); err != nil {
+		k.l.Error("Error in removing the worker nodes", "Error", err)
+		os.Exit(1)
+	}
+}
+
+// workerNodesCost is the monthly cost of the nodes in the display currency
+func (k *KsctlCommand) workerNodesCost(metaClient *controllerMeta.Controller, region string, vms []provider.VMData) (float64, string) {
+	total, code := 0.0, ""
+	for _, vm := range vms {
+		v := k.getSpecificInstanceForScaledown(metaClient, region, vm.VMSize)
+		var c float64
+		c, code = currency.Convert(v.GetCost(), v.Price.Currency)
+		total += c
+	}
+	return total, code
+}
+
+func (k *KsctlCommand) saveWorkerPools(c provider.ClusterData, pools []config.WorkerPool) {
+	if err := k.updateClusterRecord(k.clusterMetadata(c), func(r *config.ClusterRecord) {
+		r.WorkerPools = pools
+	}); err != nil {
+		k.l.Warn(k.Ctx, "Failed to record the worker pools", "Reason", err)
+	}
+}
+
+// schedulePoolNodes sets the labels and the taints of the pool on the nodes
+func (k *KsctlCommand) schedulePoolNodes(c provider.ClusterData, p config.WorkerPool, nodes []string) {
+	if len(p.Labels) == 0 && len(p.Taints) == 0 {
+		return
+	}
+
+	d, err := k.clusterNodeClient(k.Ctx, k.clusterMetadata(c))
+	if err != nil {
+		k.l.Warn(k.Ctx, "Failed to connect to the cluster to set the labels and taints of the pool", "pool", p.Name, "Reason", err)
+		return
+	}
+	for _, n := range nodes {
+		if err := d.setScheduling(k.Ctx, n, p.Labels, p.Taints); err != nil {
+			k.l.Warn(k.Ctx, "Failed to set the labels and taints of the node", "node", n, "pool", p.Name, "Reason", err)
+		}
+	}
+}
+
+// setScheduling adds the labels and the taints to the node, the taints with the same key and effect are replaced
+func (d *nodeClient) setScheduling(ctx context.Context, node string, labels map[string]string, taints []string) error {
+	n, err := d.client.Resource(nodesGVR).Get(ctx, node, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	l := n.GetLabels()
+	if l == nil {
+		l = map[string]string{}
+	}
+	maps.Copy(l, labels)
+	n.SetLabels(l)
+
+	existing, _, err := unstructured.NestedSlice(n.Object, "spec", "taints")
+	if err != nil {
+		return err
+	}
+	for _, t := range taints {
+		key, value, effect, err := splitTaint(t)
+		if err != nil {
+			return err
+		}
+		existing = slices.DeleteFunc(existing, func(e any) bool {
+			m, ok := e.(map[string]any)
+			return ok && m["key"] == key && m["effect"] == effect
+		})
+		taint := map[string]any{"key": key, "effect": effect}
+		if len(value) != 0 {
+			taint["value"] = value
+		}
+		existing = append(existing, taint)
+	}
+	if err := unstructured.SetNestedSlice(n.Object, existing, "spec", "taints"); err != nil {
+		return err
+	}
+
+	_, err = d.client.Resource(nodesGVR).Update(ctx, n, metav1.UpdateOptions{})
+	return err
+}
+
+// createWorkerPools adds the nodes of the pools other than the default one once the cluster is created
+func (k *KsctlCommand) createWorkerPools(meta controller.Metadata, pools []config.WorkerPool) {
+	if len(pools) == 0 {
+		return
+	}
+
+	c, ok := k.findSelfManagedCluster(meta)
+	if !ok {
+		k.l.Error("Failed to create the worker pools", "hint", "use ksctl cluster pool add")
+		os.Exit(1)
+	}
+
+	for _, vm := range c.WP {
+		pools[0].Nodes = append(pools[0].Nodes, vm.VMName)
+	}
+	k.saveWorkerPools(c, pools[:1])
+
+	for i := 1; i < len(pools); i++ {
+		k.l.Print(k.Ctx, "Adding the worker pool", "pool", pools[i].Name, "count", pools[i].Count, "instanceType", pools[i].InstanceType)
+		c, pools[i].Nodes = k.addWorkerNodes(c, pools[i].InstanceType, pools[i].Count)
+		k.saveWorkerPools(c, pools[:i+1])
+	}
+
+	for _, p := range pools {
+		k.schedulePoolNodes(c, p, p.Nodes)
+	}
+}
+
+// selectSelfManagedCluster returns the self-managed cluster of the args or the selected one
+// along with its worker pools
+func (k *KsctlCommand) selectSelfManagedCluster(prompt string, args []string) (provider.ClusterData, []config.WorkerPool) {
+	clusters, err := k.fetchSelfManagedClusters()
+	if err != nil {
+		k.l.Error("Error in fetching the clusters", "Error", err)
+		os.Exit(1)
+	}
+	if len(clusters) == 0 {
+		k.l.Error("There is no SelfManaged cluster")
+		os.Exit(1)
+	}
+
+	c, ok := k.clusterFromArgs(prompt, args, clusters)
+	if !ok {
+		os.Exit(1)
+	}
+
+	rec, _ := k.loadClusterRecords().Get(clusterRecordKey(c))
+	return c, workerPoolsOf(c, rec)
+}
+
+func (k *KsctlCommand) selectWorkerPool(name string, pools []config.WorkerPool) int {
+	if len(name) == 0 {
+		options := make(map[string]string, len(pools))
+		for i, p := range pools {
+			options[formatWorkerPool(p)] = strconv.Itoa(i)
+		}
+		v, err := k.menuDriven.DropDown("Select the worker pool", options)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			os.Exit(1)
+		}
+		i, _ := strconv.Atoi(v)
+		return i
+	}
+
+	i := slices.IndexFunc(pools, func(p config.WorkerPool) bool { return p.Name == name })
+	if i < 0 {
+		k.l.Error("Worker pool not found", "pool", name, "pools", strings.Join(poolNames(pools), ", "))
+		os.Exit(1)
+	}
+	return i
+}
+
+func (k *KsctlCommand) newClusterMetadataClient(c provider.ClusterData) *controllerMeta.Controller {
+	m := k.clusterMetadata(c)
+	if err := k.loadCloudProviderCreds(m.Provider); err != nil {
+		os.Exit(1)
+	}
+	metaClient, err := controllerMeta.NewController(k.Ctx, k.l, &controller.Client{Metadata: m})
+	if err != nil {
+		k.l.Error("Failed to create the controller", "Reason", err)
+		os.Exit(1)
+	}
+	return metaClient
+}
+
+func (k *KsctlCommand) Pool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pool",
+		Example: `
+ksctl cluster pool --help
+`,
+		Short: "Use to manage the worker pools of a selfmanaged cluster",
+		Long: "It is used to add, scale and remove the named worker pools of a selfmanaged cluster, every pool has its own instance type, labels and taints. " +
+			"The worker nodes which are in none of the pools make up the default pool",
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) PoolAdd() *cobra.Command {
+	name, instanceType, labels, taints := "", "", "", ""
+	count := 0
+
+	cmd := &cobra.Command{
+		Use: "add [cluster]",
+		Example: `
+ksctl cluster pool add demo
+ksctl cluster pool add demo --name memory --instance-type r5.large --count 2 --labels workload=cache --taints dedicated=cache:NoSchedule
+`,
+		Short: "Use to add a worker pool to a selfmanaged cluster",
+		Long:  "It is used to add worker nodes of an instance type as a named pool, the labels and taints are set on its nodes once they join the cluster",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, pools := k.selectSelfManagedCluster("Select the cluster to add the worker pool to", args)
+			m := k.clusterMetadata(c)
+			metaClient := k.newClusterMetadataClient(c)
+
+			p := config.WorkerPool{Name: name}
+			if len(p.Name) == 0 {
+				v, err := k.askPoolName(poolNames(pools))
+				if err != nil {
+					k.l.Error("Failed to get userinput", "Reason", err)
+					os.Exit(1)
+				}
+				p.Name = v
+			} else if err := newPoolNameValidator(poolNames(pools))(p.Name); err != nil {
+				k.l.Error("Invalid worker pool name", "pool", p.Name, "Reason", err)
+				os.Exit(1)
+			}
+
+			var vm provider.InstanceRegionOutput
+			if len(instanceType) != 0 {
+				vm = k.getSpecificInstanceForScaledown(metaClient, m.Region, instanceType)
+			} else {
+				var err error
+				vm, err = k.askWorkerInstanceType(metaClient, &m, "Select instance_type for the "+p.Name+" pool")
+				if err != nil {
+					k.l.Error("Failed to get the instance type", "Reason", err)
+					os.Exit(1)
+				}
+			}
+			if ok, err := k.confirmArchSupport(m, map[string]provider.MachineArch{roleWorkerPlane: vm.CpuArch}); err != nil {
+				k.l.Error("Failed to get userinput", "Reason", err)
+				os.Exit(1)
+			} else if !ok {
+				k.l.Error("Worker pool is not added", "Reason", "the releases of the cluster are not confirmed for the cpu architecture of the instance type")
+				os.Exit(1)
+			}
+			p.InstanceType = vm.Sku
+
+			p.Count = count
+			if cmd.Flags().Changed("count") {
+				if err := inRange(1, maxNodesPerPool)(count); err != nil {
+					k.l.Error("Invalid number of worker nodes", "count", count, "Reason", err)
+					os.Exit(1)
+				}
+			} else {
+				v, err := k.getCounterValue("Enter the number of nodes of the "+p.Name+" pool", inRange(1, maxNodesPerPool), 1)
+				if err != nil {
+					k.l.Error("Failed to get userinput", "Reason", err)
+					os.Exit(1)
+				}
+				p.Count = v
+			}
+
+			if cmd.Flags().Changed("labels") || cmd.Flags().Changed("taints") {
+				var err error
+				if p.Labels, err = parseLabels(labels); err != nil {
+					k.l.Error("Invalid labels", "Reason", err)
+					os.Exit(1)
+				}
+				if p.Taints, err = parseTaints(taints); err != nil {
+					k.l.Error("Invalid taints", "Reason", err)
+					os.Exit(1)
+				}
+			} else {
+				var err error
+				if p.Labels, p.Taints, err = k.askPoolScheduling(p.Name); err != nil {
+					k.l.Error("Failed to get userinput", "Reason", err)
+					os.Exit(1)
+				}
+			}
+
+			delta := float64(p.Count) * vm.GetCost()
+			k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will +%s (%d X %s)", currency.Format(delta, vm.Price.Currency), p.Count, vm.Sku))
+			k.enforceBudget(m, delta, vm.Price.Currency)
+
+			if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with adding the worker pool", cli.WithDefaultValue("no")); !ok {
+				os.Exit(1)
+			}
+
+			c, p.Nodes = k.addWorkerNodes(c, p.InstanceType, p.Count)
+			pools = append(pools, p)
+			k.saveWorkerPools(c, pools)
+			k.schedulePoolNodes(c, p, p.Nodes)
+
+			k.l.Success(k.Ctx, "Added the worker pool", "pool", p.Name, "nodes", strings.Join(p.Nodes, ","))
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the worker pool (default is asked)")
+	cmd.Flags().StringVar(&instanceType, "instance-type", "", "Instance type of the nodes (default is asked)")
+	cmd.Flags().IntVar(&count, "count", 0, "Number of nodes (default is asked)")
+	cmd.Flags().StringVar(&labels, "labels", "", "Labels of the nodes as key=value, comma separated")
+	cmd.Flags().StringVar(&taints, "taints", "", "Taints of the nodes as key=value:Effect, comma separated")
+	k.addOverrideBudgetFlag(cmd)
+
+	return cmd
+}
+
+func (k *KsctlCommand) PoolScale() *cobra.Command {
+	name := ""
+	count := 0
+	drainTimeout := 5 * time.Minute
+
+	cmd := &cobra.Command{
+		Use: "scale [cluster]",
+		Example: `
+ksctl cluster pool scale demo
+ksctl cluster pool scale demo --name memory --count 4
+`,
+		Short: "Use to change the number of nodes of a worker pool",
+		Long: "It is used to add nodes of the instance type of the pool, or to drain and remove its newest nodes. " +
+			"The ksctl core removes the newest worker nodes of the cluster so a pool can only shrink when its nodes are the newest ones",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, pools := k.selectSelfManagedCluster("Select the cluster of the worker pool", args)
+			i := k.selectWorkerPool(name, pools)
+			p := &pools[i]
+			current := len(p.Nodes)
+
+			validate := func(v int) error {
+				if v == current {
+					return fmt.Errorf("worker pool already has %d nodes", current)
+				}
+				return inRange(1, maxNodesPerPool)(v)
+			}
+			if cmd.Flags().Changed("count") {
+				if err := validate(count); err != nil {
+					k.l.Error("Invalid number of worker nodes", "count", count, "Reason", err)
+					os.Exit(1)
+				}
+			} else {
+				v, err := k.getCounterValue("Enter the desired number of nodes of the "+p.Name+" pool", validate, current)
+				if err != nil {
+					k.l.Error("Failed to get userinput", "Reason", err)
+					os.Exit(1)
+				}
+				count = v
+			}
+
+			metaClient := k.newClusterMetadataClient(c)
+
+			if count < current {
+				remove := p.Nodes[count:]
+				k.removeWorkerNodes(c, remove, drainTimeout, metaClient)
+				p.Nodes = p.Nodes[:count]
+				k.saveWorkerPools(c, pools)
+				k.l.Success(k.Ctx, "Scaled down the worker pool", "pool", p.Name, "removed", strings.Join(remove, ","))
+				return
+			}
+
+			// the default pool can be of several instance types, the newest one is used
+			sku := p.InstanceType
+			if j := strings.LastIndex(sku, ","); j >= 0 {
+				sku = sku[j+1:]
+			}
+			vm := k.getSpecificInstanceForScaledown(metaClient, c.Region, sku)
+
+			delta := float64(count-current) * vm.GetCost()
+			k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will +%s (%d X %s)", currency.Format(delta, vm.Price.Currency), count-current, vm.Sku))
+			k.enforceBudget(k.clusterMetadata(c), delta, vm.Price.Currency)
+
+			if ok, _ := k.menuDriven.Confirmation("Do you want to proceed with the scaleup of the worker pool", cli.WithDefaultValue("no")); !ok {
+				os.Exit(1)
+			}
+
+			var added []string
+			c, added = k.addWorkerNodes(c, vm.Sku, count-current)
+			p.Nodes = append(p.Nodes, added...)
+			k.saveWorkerPools(c, pools)
+			k.schedulePoolNodes(c, *p, added)
+
+			k.l.Success(k.Ctx, "Scaled up the worker pool", "pool", p.Name, "added", strings.Join(added, ","))
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the worker pool (default is asked)")
+	cmd.Flags().IntVar(&count, "count", 0, "Desired number of nodes (default is asked)")
+	k.addOverrideBudgetFlag(cmd)
+	cmd.Flags().DurationVar(&drainTimeout, "drain-timeout", drainTimeout, "Time to wait for the drain of every node")
+
+	return cmd
+}
+
+func (k *KsctlCommand) PoolRemove() *cobra.Command {
+	name := ""
+	drainTimeout := 5 * time.Minute
+
+	cmd := &cobra.Command{
+		Use: "remove [cluster]",
+		Example: `
+ksctl cluster pool remove demo --name memory
+`,
+		Short: "Use to remove a worker pool",
+		Long: "It is used to drain and remove all the nodes of a worker pool. " +
+			"The ksctl core removes the newest worker nodes of the cluster so only the pool with the newest nodes can be removed",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, pools := k.selectSelfManagedCluster("Select the cluster of the worker pool", args)
+			i := k.selectWorkerPool(name, pools)
+			p := pools[i]
+
+			if len(p.Nodes) != 0 {
+				k.removeWorkerNodes(c, p.Nodes, drainTimeout, k.newClusterMetadataClient(c))
+			}
+
+			k.saveWorkerPools(c, slices.Delete(pools, i, i+1))
+			k.l.Success(k.Ctx, "Removed the worker pool", "pool", p.Name)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the worker pool (default is asked)")
+	cmd.Flags().DurationVar(&drainTimeout, "drain-timeout", drainTimeout, "Time to wait for the drain of every node")
+
+	return cmd
+}
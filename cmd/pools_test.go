@@ -0,0 +1,85 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+func TestWorkerPoolsOf(t *testing.T) {
+	c := provider.ClusterData{
+		WP: []provider.VMData{
+			{VMName: "wp-0", VMSize: "small"},
+			{VMName: "wp-1", VMSize: "medium"},
+			{VMName: "wp-2", VMSize: "large"},
+			{VMName: "wp-3", VMSize: "large"},
+		},
+	}
+	gpu := config.WorkerPool{
+		Name:         "gpu",
+		InstanceType: "large",
+		Nodes:        []string{"wp-2", "wp-3", "wp-gone"},
+		Taints:       []string{"gpu=true:NoSchedule"},
+	}
+
+	for _, tc := range []struct {
+		name string
+		c    provider.ClusterData
+		rec  *config.ClusterRecord
+		want []config.WorkerPool
+	}{
+		{
+			name: "without a record every node is in the default pool",
+			c:    c,
+			want: []config.WorkerPool{
+				{Name: defaultPoolName, InstanceType: "small,medium,large", Nodes: []string{"wp-0", "wp-1", "wp-2", "wp-3"}},
+			},
+		},
+		{
+			name: "named pools drop the removed nodes",
+			c:    c,
+			rec: &config.ClusterRecord{WorkerPools: []config.WorkerPool{
+				{Name: defaultPoolName, Labels: map[string]string{"tier": "base"}},
+				gpu,
+			}},
+			want: []config.WorkerPool{
+				{Name: defaultPoolName, InstanceType: "small,medium", Nodes: []string{"wp-0", "wp-1"}, Labels: map[string]string{"tier": "base"}},
+				{Name: "gpu", InstanceType: "large", Nodes: []string{"wp-2", "wp-3"}, Taints: []string{"gpu=true:NoSchedule"}},
+			},
+		},
+		{
+			name: "empty default pool is left out",
+			c:    provider.ClusterData{WP: c.WP[2:]},
+			rec:  &config.ClusterRecord{WorkerPools: []config.WorkerPool{gpu}},
+			want: []config.WorkerPool{
+				{Name: "gpu", InstanceType: "large", Nodes: []string{"wp-2", "wp-3"}, Taints: []string{"gpu=true:NoSchedule"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := workerPoolsOf(tc.c, tc.rec); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+
+	if len(gpu.Nodes) != 3 {
+		t.Errorf("workerPoolsOf changed the nodes of the record: %v", gpu.Nodes)
+	}
+}
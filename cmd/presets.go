@@ -0,0 +1,624 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/addons"
+	"github.com/ksctl/ksctl/v2/pkg/bootstrap/handler/cni"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/ksctl/ksctl/v2/pkg/utilities"
+	"github.com/spf13/cobra"
+)
+
+// applyPreset answers the steps covered by the preset, the name, the region and the cloud
+// when the preset doesn't set it are the only questions left
+func (w *createWizard) applyPreset(p *config.Preset) {
+	w.preset = p
+
+	instance := func(role, prompt string, vm *provider.InstanceRegionOutput, sku *string) func() error {
+		return func() error {
+			v, err := w.presetInstance(role, prompt)
+			if err != nil {
+				return err
+			}
+			*vm = v
+			*sku = v.Sku
+			return nil
+		}
+	}
+	answer := func(f func()) func() error {
+		return func() error {
+			f()
+			return nil
+		}
+	}
+
+	autos := map[string]func() error{
+		stepClusterType: answer(func() { w.meta.ClusterType = p.ClusterType }),
+		stepBootstrap:   answer(func() { w.meta.K8sDistro = p.K8sDistro }),
+		stepArch:        answer(w.presetArch),
+
+		stepControlPlane: instance(roleControlPlane, "Select instance_type for Control Plane", &w.cp, &w.meta.ControlPlaneNodeType),
+		stepDataStore:    instance(roleDataStore, "Select instance_type for Etcd Nodes", &w.etcd, &w.meta.DataStoreNodeType),
+		stepLoadBalancer: instance(roleLoadBalancer, "Select instance_type for Load Balancer", &w.lb, &w.meta.LoadBalancerNodeType),
+		stepWorkerPlane: func() error {
+			w.defaultWP = p.Roles[roleWorkerPlane].Count
+			return instance(roleWorkerPlane, "Select instance_type for Worker Nodes", &w.wp, &w.meta.WorkerPlaneNodeType)()
+		},
+		stepManagedNodes: func() error {
+			w.defaultMP = p.Roles[roleManagedNodes].Count
+			return instance(roleManagedNodes, "Select instance_type for Managed Nodes", &w.vm, &w.meta.ManagedNodeType)()
+		},
+		stepManagedOffering: w.presetManagedOffering,
+
+		stepNoCP: answer(func() { w.meta.NoCP = p.Roles[roleControlPlane].Count }),
+		stepNoWP: answer(func() { w.meta.NoWP = p.Roles[roleWorkerPlane].Count }),
+		stepNoDS: answer(func() { w.meta.NoDS = p.Roles[roleDataStore].Count }),
+		stepNoMP: answer(func() { w.meta.NoMP = p.Roles[roleManagedNodes].Count }),
+
+		stepWorkerPools: answer(func() { w.pools = nil }),
+
+		// the preset decides the shape of the cluster, the recommendations would only prompt
+		stepRightSizing:   answer(func() {}),
+		stepCostOptimizer: answer(func() {}),
+
+		stepBootstrapVersion: func() (err error) {
+			w.meta.K8sVersion, err = w.latestVersion(w.metaClient.ListAllBootstrapVersions, "bootstrap")
+			return err
+		},
+		stepEtcdVersion: func() (err error) {
+			w.meta.EtcdVersion, err = w.latestVersion(w.metaClient.ListAllEtcdVersions, "etcd")
+			return err
+		},
+		stepPrice: w.checkBudget,
+		stepCNI:   w.presetCNI,
+		stepManagedVersion: func() (err error) {
+			w.meta.K8sVersion, err = w.latestVersion(func() ([]string, error) {
+				return w.metaClient.ListAllManagedClusterK8sVersions(w.meta.Region)
+			}, "managed cluster k8s")
+			return err
+		},
+	}
+	for step, role := range map[string]string{stepNoCP: roleControlPlane, stepNoDS: roleDataStore} {
+		if err := oddQuorum(3)(p.Roles[role].Count); p.ClusterType == consts.ClusterTypeSelfMang && err != nil {
+			w.k.l.Warn(w.k.Ctx, "The preset count is asked instead", "preset", p.Name, "role", role, "Reason", err)
+			delete(autos, step)
+		}
+	}
+	if len(p.Provider) != 0 {
+		autos[stepCloudProvider] = func() error {
+			w.meta.Provider = p.Provider
+			return w.loadCloudProviderCreds()
+		}
+	}
+
+	for i := range w.steps {
+		if f, ok := autos[w.steps[i].name]; ok {
+			w.steps[i].auto = f
+		}
+	}
+
+	w.k.l.Print(w.k.Ctx, "Using the preset", "preset", p.Name, "description", p.Description)
+}
+
+func (w *createWizard) presetArch() {
+	roles := []string{roleManagedNodes}
+	if w.meta.ClusterType == consts.ClusterTypeSelfMang {
+		roles = []string{roleControlPlane, roleDataStore, roleLoadBalancer, roleWorkerPlane}
+	}
+
+	w.archs = make(map[string]provider.MachineArch, len(roles))
+	for _, r := range roles {
+		if w.meta.Provider == consts.CloudLocal {
+			w.archs[r] = provider.ArchAmd64
+		} else {
+			w.archs[r] = w.preset.Arch
+		}
+	}
+}
+
+// presetInstanceType returns the pinned instance type of the role if the region offers it,
+// otherwise the cheapest one of the category with at least the vCPUs and memory of the role.
+// A pinned role without a shape has nothing to compare with, so nothing is returned
+func presetInstanceType(vms provider.InstancesRegionOutput, r config.PresetRole, arch provider.MachineArch, anyCategory bool) (provider.InstanceRegionOutput, bool) {
+	if len(r.InstanceType) != 0 {
+		for _, v := range vms {
+			if v.Sku == r.InstanceType && (len(arch) == 0 || v.CpuArch == arch) {
+				return v, true
+			}
+		}
+		if len(r.Category) == 0 && r.VCpus == 0 && r.Memory == 0 {
+			return provider.InstanceRegionOutput{}, false
+		}
+	}
+
+	best := -1
+	for i, v := range vms {
+		if !anyCategory && len(r.Category) != 0 && v.Category != r.Category {
+			continue
+		}
+		if (len(arch) != 0 && v.CpuArch != arch) || v.VCpus < r.VCpus || v.Memory < r.Memory {
+			continue
+		}
+		if best < 0 || v.GetCost() < vms[best].GetCost() ||
+			(v.GetCost() == vms[best].GetCost() && v.VCpus < vms[best].VCpus) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return provider.InstanceRegionOutput{}, false
+	}
+	return vms[best], true
+}
+
+// presetInstance picks the instance type of the role, the user picks it when nothing in the region fits
+func (w *createWizard) presetInstance(role, prompt string) (provider.InstanceRegionOutput, error) {
+	r := w.preset.Roles[role]
+	local := w.meta.Provider == consts.CloudLocal
+
+	vms, err := w.k.instanceTypesInRegion(w.metaClient, w.meta)
+	if err != nil {
+		return provider.InstanceRegionOutput{}, err
+	}
+	if v, ok := presetInstanceType(vms, r, w.archs[role], local); ok {
+		w.k.l.Print(w.k.Ctx, "Preset picked the instance type", "role", role, "instanceType", v.Sku)
+		return v, nil
+	}
+
+	w.k.l.Warn(w.k.Ctx, "No instance type in the region fits the preset, pick one", "role", role, "preset", w.preset.Name)
+	category := provider.Unknown
+	if !local {
+		if category, err = w.k.handleInstanceCategorySelection(); err != nil {
+			return provider.InstanceRegionOutput{}, err
+		}
+	}
+	return w.k.handleInstanceTypeSelection(w.metaClient, w.meta, category, w.archs[role], prompt)
+}
+
+// presetManagedOffering picks the pinned offering if the region has it, otherwise the cheapest one
+func (w *createWizard) presetManagedOffering() error {
+	offerings, err := w.listManagedOfferings()
+	if err != nil {
+		return err
+	}
+
+	if v, ok := offerings[w.preset.ManagedOffering]; ok {
+		w.offering = v
+		return nil
+	}
+
+	keys := make([]string, 0, len(offerings))
+	for k := range offerings {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("no managed cluster offering in the region %s", w.meta.Region)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		switch ca, cb := offerings[a].GetCost(), offerings[b].GetCost(); {
+		case ca < cb:
+			return -1
+		case ca > cb:
+			return 1
+		}
+		return strings.Compare(a, b)
+	})
+	w.offering = offerings[keys[0]]
+	return nil
+}
+
+// latestVersion returns the first version of the list which is the latest one
+func (w *createWizard) latestVersion(list func() ([]string, error), what string) (string, error) {
+	vers, err := list()
+	if err != nil {
+		return "", fmt.Errorf("failed to get the list of %s versions: %w", what, err)
+	}
+	if len(vers) == 0 {
+		return "", fmt.Errorf("no %s version is available", what)
+	}
+	return vers[0], nil
+}
+
+// cniAddons returns the named cni the way handleCNI builds it, the cni of the offering is used
+// when it has the cni otherwise the one provided by ksctl, an empty name means the default one
+func cniAddons(cniName string, ciliumGuided []string, managedCNI addons.ClusterAddons, defaultManaged string, ksctlCNI addons.ClusterAddons, defaultKsctl string) (addons.ClusterAddons, error) {
+	find := func(vc addons.ClusterAddons, name string) (addons.ClusterAddon, bool) {
+		for _, c := range vc {
+			if c.Name == name {
+				return c, true
+			}
+		}
+		return addons.ClusterAddon{}, false
+	}
+
+	name := cniName
+	if len(name) == 0 {
+		name = defaultManaged
+	}
+	if name != string(consts.CNINone) {
+		if c, ok := find(managedCNI, name); ok {
+			return addons.ClusterAddons{c}, nil
+		}
+	}
+
+	none, ok := find(managedCNI, string(consts.CNINone))
+	if !ok {
+		return nil, fmt.Errorf("cni %s is not offered", name)
+	}
+	if name == string(consts.CNINone) && len(cniName) == 0 {
+		name = defaultKsctl
+	}
+	c, ok := find(ksctlCNI, name)
+	if !ok {
+		return nil, fmt.Errorf("cni %s is not offered", name)
+	}
+
+	cfg := make(map[string]map[string]any)
+	if c.Name == string(consts.CNICilium) && len(ciliumGuided) != 0 {
+		cfg[string(cni.CiliumComponentID)] = map[string]any{
+			"guidedConfig": ciliumGuided,
+		}
+	}
+	_cfg, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.Config = utilities.Ptr(string(_cfg))
+
+	return addons.ClusterAddons{none, c}, nil
+}
+
+func (w *createWizard) presetCNI() error {
+	managedCNI, defaultCNI, ksctlCNI, defaultKsctl, err := w.listCNIs()
+	if err != nil {
+		return err
+	}
+
+	v, err := cniAddons(w.preset.CNI, w.preset.CiliumGuided, managedCNI, defaultCNI, ksctlCNI, defaultKsctl)
+	if err != nil {
+		w.k.l.Warn(w.k.Ctx, "The preset CNI can't be used, pick one", "preset", w.preset.Name, "Reason", err)
+		return w.askCNI()
+	}
+
+	known := []string{}
+	for _, g := range cni.CiliumGuidedConfigurations() {
+		known = append(known, g.Name)
+	}
+	for _, g := range w.preset.CiliumGuided {
+		if !slices.Contains(known, g) {
+			w.k.l.Warn(w.k.Ctx, "Unknown cilium guided configuration in the preset", "preset", w.preset.Name, "config", g)
+		}
+	}
+
+	for _, a := range w.preset.Addons {
+		cfg, err := json.Marshal(a.Config)
+		if err != nil {
+			return fmt.Errorf("failed to marshal the config of the addon %s: %w", a.Name, err)
+		}
+		v = append(v, addons.ClusterAddon{
+			Name:   a.Name,
+			Label:  a.Label,
+			Config: utilities.Ptr(string(cfg)),
+		})
+	}
+
+	w.meta.Addons = v
+	return nil
+}
+
+func (k *KsctlCommand) Presets() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "presets",
+		Example: `
+ksctl presets --help
+`,
+		Short: "Use to manage the cluster presets",
+		Long:  "It is used to list and show the built-in and user defined presets used by $ksctl cluster create --preset <name>, and to create presets from the existing clusters",
+	}
+
+	return cmd
+}
+
+func presetSource(p config.Preset) string {
+	if p.Builtin {
+		return "built-in"
+	}
+	return "user"
+}
+
+// presetNodes describes the count and the shape of the nodes of every role
+func presetNodes(p config.Preset, verbose bool) []string {
+	roles := []string{roleManagedNodes}
+	if p.ClusterType == consts.ClusterTypeSelfMang {
+		roles = []string{roleControlPlane, roleDataStore, roleLoadBalancer, roleWorkerPlane}
+	}
+
+	res := []string{}
+	for _, role := range roles {
+		r := p.Roles[role]
+		s := fmt.Sprintf("%d %s", r.Count, role)
+		if !verbose {
+			res = append(res, s)
+			continue
+		}
+
+		shape := []string{}
+		if len(r.InstanceType) != 0 {
+			shape = append(shape, r.InstanceType)
+		}
+		if len(r.Category) != 0 {
+			shape = append(shape, "category "+string(r.Category))
+		}
+		if r.VCpus != 0 {
+			shape = append(shape, fmt.Sprintf(">= %d vCPU", r.VCpus))
+		}
+		if r.Memory != 0 {
+			shape = append(shape, fmt.Sprintf(">= %d GB", r.Memory))
+		}
+		if len(shape) != 0 {
+			s += " (" + strings.Join(shape, ", ") + ")"
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func orDefault(v, def string) string {
+	if len(v) == 0 {
+		return def
+	}
+	return v
+}
+
+func (k *KsctlCommand) PresetsList() *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use: "list",
+		Example: `
+ksctl presets list
+`,
+		Short: "Use to list the presets",
+		Long:  "It is used to list the built-in presets followed by the user defined ones",
+		Run: func(cmd *cobra.Command, args []string) {
+			presets, malformed, err := config.ListPresets()
+			if err != nil {
+				k.l.Error("Failed to list the presets", "Reason", err)
+				os.Exit(1)
+			}
+			for name, err := range malformed {
+				k.l.Warn(k.Ctx, "Skipping the preset as it can't be loaded", "preset", name, "Reason", err)
+			}
+
+			headers := []string{"Name", "Type", "Cloud", "Distro", "Nodes", "CNI", "Source", "Description"}
+			rows := make([][]string, 0, len(presets))
+			for _, p := range presets {
+				rows = append(rows, []string{
+					p.Name,
+					string(p.ClusterType),
+					orDefault(string(p.Provider), "asked"),
+					orDefault(string(p.K8sDistro), "-"),
+					strings.Join(presetNodes(p, false), ", "),
+					orDefault(p.CNI, "default"),
+					presetSource(p),
+					p.Description,
+				})
+			}
+			k.l.Table(k.Ctx, headers, rows)
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) PresetsShow() *cobra.Command {
+	output := ""
+
+	cmd := &cobra.Command{
+		Use: "show <preset>",
+		Example: `
+ksctl presets show ha
+ksctl presets show ha --output json
+`,
+		Short: "Use to show a preset",
+		Long:  "It is used to show the answers pre-filled by a preset",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			p := config.Preset{}
+			if err := config.LoadPreset(args[0], &p); err != nil {
+				k.l.Error("Failed to load the preset", "Reason", err)
+				os.Exit(1)
+			}
+
+			if output == cli.OutputJson {
+				if err := printJson(p); err != nil {
+					k.l.Error("Failed to print the preset", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			lines := []string{
+				"Description: " + p.Description,
+				"Source: " + presetSource(p),
+				"Cluster Type: " + color.HiCyanString(string(p.ClusterType)),
+				"Cloud: " + orDefault(string(p.Provider), "asked"),
+				"Distro: " + orDefault(string(p.K8sDistro), "-"),
+				"Arch: " + orDefault(string(p.Arch), archAny),
+				"Nodes:\n  " + strings.Join(presetNodes(p, true), "\n  "),
+				"CNI: " + orDefault(p.CNI, "default"),
+			}
+			if len(p.ManagedOffering) != 0 {
+				lines = append(lines, "Managed Offering: "+p.ManagedOffering)
+			}
+			if len(p.CiliumGuided) != 0 {
+				lines = append(lines, "Cilium Guided: "+strings.Join(p.CiliumGuided, ", "))
+			}
+			if len(p.Addons) != 0 {
+				names := make([]string, 0, len(p.Addons))
+				for _, a := range p.Addons {
+					names = append(names, a.Name)
+				}
+				lines = append(lines, "Addons: "+strings.Join(names, ", "))
+			}
+
+			k.l.Box(k.Ctx, "Preset "+p.Name, strings.Join(lines, "\n"))
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+
+	return cmd
+}
+
+// presetFromCluster pins the instance types and the counts of the cluster, the shape of the
+// instance types in vms is kept so that a region without them gets the closest ones.
+// The addons are not part of the preset as the state only has their names
+func presetFromCluster(name string, c provider.ClusterData, vms provider.InstancesRegionOutput) config.Preset {
+	p := config.Preset{
+		Name:        name,
+		Description: fmt.Sprintf("Created from the cluster %s", c.Name),
+		ClusterType: c.ClusterType,
+		Provider:    c.CloudProvider,
+	}
+	p.CNI, _ = splitAppVersion(c.Cni)
+
+	size := func(vms []provider.VMData) string {
+		if len(vms) == 0 {
+			return ""
+		}
+		return vms[0].VMSize
+	}
+
+	archs := map[provider.MachineArch]bool{}
+	role := func(count int, sku string) config.PresetRole {
+		r := config.PresetRole{Count: count, InstanceType: sku}
+		if v, ok := vms.Get(sku); ok {
+			r.Category = v.Category
+			r.VCpus = v.VCpus
+			r.Memory = v.Memory
+			archs[v.CpuArch] = true
+		}
+		return r
+	}
+
+	if c.ClusterType == consts.ClusterTypeSelfMang {
+		p.K8sDistro = c.K8sDistro
+		p.Roles = map[string]config.PresetRole{
+			roleControlPlane: role(c.NoCP, size(c.CP)),
+			roleDataStore:    role(c.NoDS, size(c.DS)),
+			roleLoadBalancer: role(1, c.LB.VMSize),
+			roleWorkerPlane:  role(c.NoWP, size(c.WP)),
+		}
+	} else {
+		p.Roles = map[string]config.PresetRole{
+			roleManagedNodes: role(c.NoMgt, c.Mgt.VMSize),
+		}
+	}
+
+	if len(archs) == 1 {
+		for a := range archs {
+			p.Arch = a
+		}
+	}
+	return p
+}
+
+func (k *KsctlCommand) PresetsCreateFrom() *cobra.Command {
+	name := ""
+
+	cmd := &cobra.Command{
+		Use: "create-from <cluster>",
+		Example: `
+ksctl presets create-from demo
+ksctl presets create-from demo --name team-default
+`,
+		Short: "Use to create a preset from an existing cluster",
+		Long:  "It is used to save the cloud, the instance types with their category, vCPUs and memory, the node counts and the cni of an existing cluster as a user defined preset",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchAllClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+
+			c, ok := k.selectClusterByName("Select the cluster to create the preset from", args[0], clusters)
+			if !ok {
+				os.Exit(1)
+			}
+
+			if len(name) == 0 {
+				name = c.Name
+			}
+			if !config.IsValidDraftName(name) {
+				k.l.Error("Invalid preset name", "name", name, "allowed", "letters, digits, '.', '_' and '-'")
+				os.Exit(1)
+			}
+			if config.IsBuiltinPreset(name) {
+				k.l.Error("Preset name is used by a built-in preset", "name", name)
+				os.Exit(1)
+			}
+
+			if err := config.LoadPreset(name, new(config.Preset)); err == nil {
+				proceed, err := k.menuDriven.Confirmation(fmt.Sprintf("Preset %s already exists, overwrite it?", name), cli.WithDefaultValue("no"))
+				if err != nil {
+					k.l.Error("Failed to get userinput", "Reason", err)
+					os.Exit(1)
+				}
+				if !proceed {
+					os.Exit(1)
+				}
+			}
+
+			var vms provider.InstancesRegionOutput
+			if c.CloudProvider != consts.CloudLocal {
+				vms, err = k.newInstancePricing().instances(c)
+				if err != nil {
+					k.l.Warn(k.Ctx, "Failed to get the instance types of the region, the preset only pins them", "Reason", err)
+					proceed, err := k.menuDriven.Confirmation("Save the preset without the shape of the instance types? A region without them asks for the instance types", cli.WithDefaultValue("no"))
+					if err != nil {
+						k.l.Error("Failed to get userinput", "Reason", err)
+						os.Exit(1)
+					}
+					if !proceed {
+						os.Exit(1)
+					}
+				}
+			}
+
+			p := presetFromCluster(name, c, vms)
+			if err := config.SavePreset(&p); err != nil {
+				k.l.Error("Failed to save the preset", "Reason", err)
+				os.Exit(1)
+			}
+			k.l.Success(k.Ctx, "Created the preset", "preset", p.Name, "usage", "ksctl cluster create --preset "+p.Name)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the preset (default is the cluster name)")
+
+	return cmd
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+func TestPresetInstanceType(t *testing.T) {
+	vms := provider.InstancesRegionOutput{
+		testInstance("small", provider.ArchAmd64, 2, 4, 0.05),
+		testInstance("large", provider.ArchAmd64, 8, 32, 0.4),
+		testInstance("medium", provider.ArchAmd64, 4, 16, 0.2),
+		testInstance("medium-arm", provider.ArchArm64, 4, 16, 0.15),
+	}
+
+	for _, tc := range []struct {
+		name string
+		role config.PresetRole
+		arch provider.MachineArch
+		want string
+		ok   bool
+	}{
+		{"pinned and offered", config.PresetRole{InstanceType: "large", VCpus: 2}, provider.ArchAmd64, "large", true},
+		{"pinned with another arch falls back to the shape", config.PresetRole{InstanceType: "medium-arm", VCpus: 4, Memory: 16}, provider.ArchAmd64, "medium", true},
+		{"missing pin falls back to the shape", config.PresetRole{InstanceType: "gone", VCpus: 4, Memory: 8}, provider.ArchAmd64, "medium", true},
+		{"missing pin without a shape is left to the user", config.PresetRole{InstanceType: "gone"}, provider.ArchAmd64, "", false},
+		{"shape picks the cheapest fitting one", config.PresetRole{VCpus: 4, Memory: 16}, "", "medium-arm", true},
+		{"nothing fits", config.PresetRole{VCpus: 16}, "", "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := presetInstanceType(vms, tc.role, tc.arch, true)
+			if got.Sku != tc.want || ok != tc.ok {
+				t.Errorf("got %q, %v, want %q, %v", got.Sku, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestPresetFromCluster(t *testing.T) {
+	general := testInstance("m5.large", provider.ArchAmd64, 2, 8, 0.1)
+	general.Category = provider.GeneralPurpose
+	vms := provider.InstancesRegionOutput{general}
+
+	c := provider.ClusterData{
+		Name:          "demo",
+		CloudProvider: consts.CloudAws,
+		ClusterType:   consts.ClusterTypeMang,
+		NoMgt:         3,
+		Mgt:           provider.VMData{VMSize: "m5.large"},
+	}
+
+	p := presetFromCluster("demo", c, vms)
+	want := config.PresetRole{Count: 3, Category: provider.GeneralPurpose, VCpus: 2, Memory: 8, InstanceType: "m5.large"}
+	if got := p.Roles[roleManagedNodes]; got != want {
+		t.Errorf("managed nodes = %+v, want %+v", got, want)
+	}
+	if p.Arch != provider.ArchAmd64 {
+		t.Errorf("arch = %q, want %q", p.Arch, provider.ArchAmd64)
+	}
+
+	p = presetFromCluster("demo", c, nil)
+	want = config.PresetRole{Count: 3, InstanceType: "m5.large"}
+	if got := p.Roles[roleManagedNodes]; got != want || len(p.Arch) != 0 {
+		t.Errorf("without the catalog = %+v, %q, want %+v", got, p.Arch, want)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+const overrideProtectionFlag = "override-protection"
+
+// criticalAddons are the addons the cluster can't work without
+var criticalAddons = []string{string(consts.CNICilium), string(consts.CNIFlannel)}
+
+func (k *KsctlCommand) isProtected(m controller.Metadata) (bool, error) {
+	records, err := k.readClusterRecords()
+	if err != nil {
+		return false, err
+	}
+	rec, ok := records.Get(metadataRecordKey(m))
+	return ok && rec.Protected, nil
+}
+
+// protectionError is why the operation can't run on the cluster, the protection which can't be
+// read blocks the operation as well so that the guard never fails open
+func (k *KsctlCommand) protectionError(m controller.Metadata, operation string, override bool) error {
+	protected, err := k.isProtected(m)
+	switch {
+	case err == nil && !protected:
+		return nil
+	case override:
+		k.l.Warn(k.Ctx, "Overriding the protection of the cluster", "cluster", m.ClusterName, "operation", operation)
+		return nil
+	case err != nil:
+		return fmt.Errorf("unable to check the protection of the cluster: %w", err)
+	default:
+		return fmt.Errorf("the cluster is protected")
+	}
+}
+
+// guardProtected stops the operation on a protected cluster unless the protection is overridden
+func (k *KsctlCommand) guardProtected(m controller.Metadata, operation string, override bool) {
+	if err := k.protectionError(m, operation, override); err != nil {
+		k.l.Error("Operation is not allowed", "cluster", m.ClusterName, "operation", operation, "Reason", err,
+			"hint", fmt.Sprintf("ksctl cluster unprotect %s, or pass --%s", m.ClusterName, overrideProtectionFlag))
+		os.Exit(1)
+	}
+}
+
+// confirmClusterName asks to type the name of the cluster, a single keystroke is too easy for a destructive operation
+func (k *KsctlCommand) confirmClusterName(name, operation string) bool {
+	return k.confirmTyped(fmt.Sprintf("Type the name of the cluster (%s) to confirm the %s", name, operation), name)
+}
+
+// confirmClusterNames asks to type the name of every cluster, nothing is confirmed when any of them doesn't match
+func (k *KsctlCommand) confirmClusterNames(clusters []provider.ClusterData, operation string) bool {
+	for i, c := range clusters {
+		if !k.confirmTyped(fmt.Sprintf("[%d/%d] Type the name of the cluster (%s) to confirm the %s", i+1, len(clusters), c.Name, operation), c.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+func (k *KsctlCommand) confirmTyped(prompt, expected string) bool {
+	v, err := k.menuDriven.TextInput(prompt)
+	if err != nil {
+		k.l.Error("Failed to get userinput", "Reason", err)
+		return false
+	}
+	if strings.TrimSpace(v) != expected {
+		k.l.Error("The input doesn't match, nothing is changed", "expected", expected)
+		return false
+	}
+	return true
+}
+
+func (k *KsctlCommand) setProtection(args []string, protected bool) {
+	clusters, err := k.fetchAllClusters()
+	if err != nil {
+		k.l.Error("Error in fetching the clusters", "Error", err)
+		os.Exit(1)
+	}
+
+	c, ok := k.clusterFromArgs("Select the cluster", args, clusters)
+	if !ok {
+		os.Exit(1)
+	}
+
+	if err := k.updateClusterRecord(k.clusterMetadata(c), func(r *config.ClusterRecord) {
+		r.Protected = protected
+	}); err != nil {
+		k.l.Error("Failed to update the cluster records", "Reason", err)
+		os.Exit(1)
+	}
+
+	if protected {
+		k.l.Success(k.Ctx, "Protected the cluster", "cluster", c.Name)
+	} else {
+		k.l.Success(k.Ctx, "Removed the protection of the cluster", "cluster", c.Name)
+	}
+}
+
+func (k *KsctlCommand) Protect() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "protect [cluster]",
+		Example: `
+ksctl cluster protect prod
+`,
+		Short: "Use to protect a cluster from the destructive operations",
+		Long: "It is used to make delete, reap, scaledown to 0 worker nodes and disabling the critical addons " +
+			"refuse to run on the cluster unless --" + overrideProtectionFlag + " is passed",
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			k.setProtection(args, true)
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) Unprotect() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unprotect [cluster]",
+		Example: `
+ksctl cluster unprotect prod
+`,
+		Short: "Use to remove the protection of a cluster",
+		Long:  "It is used to allow the destructive operations on a protected cluster again",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			k.setProtection(args, false)
+		},
+	}
+
+	return cmd
+}
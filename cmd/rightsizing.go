@@ -0,0 +1,283 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	controllerMeta "github.com/ksctl/ksctl/v2/pkg/handler/cluster/metadata"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+const (
+	// targetUtilization leaves the headroom for spikes, daemonsets and rolling updates
+	targetUtilization = 0.75
+	// maxPodsPerNode is the kubelet default
+	maxPodsPerNode             = 110
+	maxNodesPerPool            = 100
+	maxWorkloadRecommendations = 6
+)
+
+// workloadSpec is the expected workload, the cpu and memory are the sum of the requests
+type workloadSpec struct {
+	CPU    float64
+	Memory float64
+	Pods   int
+	// HA keeps a spare node so that the workload survives the loss of any node
+	HA bool
+	// Arch limits the instance types to the architecture, empty means any
+	Arch provider.MachineArch
+}
+
+// recommendNodePools sizes a node pool of every instance type for the workload and returns the cheapest ones
+func recommendNodePools(vms provider.InstancesRegionOutput, w workloadSpec) []cli.WorkloadRecommendation {
+	res := []cli.WorkloadRecommendation{}
+
+	for _, vm := range vms {
+		if (len(w.Arch) != 0 && vm.CpuArch != w.Arch) || vm.VCpus <= 0 || vm.Memory <= 0 {
+			continue
+		}
+
+		count := max(
+			int(math.Ceil(w.CPU/(float64(vm.VCpus)*targetUtilization))),
+			int(math.Ceil(w.Memory/(float64(vm.Memory)*targetUtilization))),
+			int(math.Ceil(float64(w.Pods)/maxPodsPerNode)),
+			1,
+		)
+		if w.HA {
+			count = max(count+1, 2)
+		}
+		if count > maxNodesPerPool {
+			continue
+		}
+
+		res = append(res, cli.WorkloadRecommendation{
+			Instance:          vm,
+			Count:             count,
+			CPUUtilization:    w.CPU / float64(count*vm.VCpus) * 100,
+			MemoryUtilization: w.Memory / float64(count*vm.Memory) * 100,
+			PodsPerNode:       int(math.Ceil(float64(w.Pods) / float64(count))),
+		})
+	}
+
+	slices.SortFunc(res, func(a, b cli.WorkloadRecommendation) int {
+		switch {
+		case a.MonthlyCost() < b.MonthlyCost():
+			return -1
+		case a.MonthlyCost() > b.MonthlyCost():
+			return 1
+		}
+		return a.Count - b.Count
+	})
+
+	if len(res) > maxWorkloadRecommendations {
+		res = res[:maxWorkloadRecommendations]
+	}
+	return res
+}
+
+func (k *KsctlCommand) getWorkloadSpec() (workloadSpec, error) {
+	w := workloadSpec{}
+
+	v, err := k.getFloatValue("Enter the total CPU requests of the workload (cores)", positiveFloat, 4)
+	if err != nil {
+		return w, err
+	}
+	w.CPU = v
+
+	v, err = k.getFloatValue("Enter the total memory requests of the workload (GB)", positiveFloat, 8)
+	if err != nil {
+		return w, err
+	}
+	w.Memory = v
+
+	pods, err := k.getCounterValue("Enter the expected number of pods", atLeast(0), 30)
+	if err != nil {
+		return w, err
+	}
+	w.Pods = pods
+
+	ha, err := k.menuDriven.Confirmation("Should the workload survive the loss of a node?", cli.WithDefaultValue("yes"))
+	if err != nil {
+		return w, err
+	}
+	w.HA = ha
+
+	return w, nil
+}
+
+// handleWorkloadRightSizing is the optional step which proposes the worker instance type and count
+// from the expected workload, it returns false when the user wants to pick them manually
+func (k *KsctlCommand) handleWorkloadRightSizing(meta *controllerMeta.Controller, m *controller.Metadata, arch provider.MachineArch) (cli.WorkloadRecommendation, bool, error) {
+	if m.Provider == consts.CloudLocal {
+		return cli.WorkloadRecommendation{}, false, nil
+	}
+
+	guided, err := k.menuDriven.Confirmation("Do you want the worker nodes sized from your expected workload?", cli.WithDefaultValue("no"))
+	if err != nil {
+		return cli.WorkloadRecommendation{}, false, err
+	}
+	if !guided {
+		return cli.WorkloadRecommendation{}, false, nil
+	}
+
+	w, err := k.getWorkloadSpec()
+	if isBack(err) {
+		return cli.WorkloadRecommendation{}, false, err
+	}
+	if err != nil {
+		k.l.Warn(k.Ctx, "Falling back to manual selection of the worker nodes", "Reason", err)
+		return cli.WorkloadRecommendation{}, false, nil
+	}
+	w.Arch = arch
+
+	vms, err := k.instanceTypesInRegion(meta, m)
+	if err != nil {
+		return cli.WorkloadRecommendation{}, false, err
+	}
+
+	recs := recommendNodePools(vms, w)
+	if len(recs) == 0 {
+		k.l.Warn(k.Ctx, "No instance type can fit the workload, falling back to manual selection")
+		return cli.WorkloadRecommendation{}, false, nil
+	}
+
+	k.l.Note(k.Ctx, "Node pools sized for the workload", "headroom", "25%", "ha", w.HA)
+
+	sku, err := k.menuDriven.CardSelection(cli.ConverterForWorkloadRecommendationsForCards(recs))
+	if err != nil {
+		return cli.WorkloadRecommendation{}, false, fmt.Errorf("failed to get the node pool from user: %w", err)
+	}
+
+	for _, r := range recs {
+		if r.Instance.Sku == sku {
+			k.l.Print(k.Ctx, "Selected the node pool", "instanceType", r.Instance.Sku, "count", r.Count)
+			return r, true, nil
+		}
+	}
+
+	return cli.WorkloadRecommendation{}, false, nil
+}
+
+const maxInstanceAlternatives = 6
+
+// isGreener compares the embodied emissions when both are known otherwise the estimated energy
+func isGreener(v, current provider.InstanceRegionOutput) bool {
+	ve, ok1 := monthlyEmbodied(v)
+	ce, ok2 := monthlyEmbodied(current)
+	if ok1 && ok2 {
+		return ve < ce
+	}
+	return monthlyEnergy(v) < monthlyEnergy(current)
+}
+
+// instanceAlternatives returns the instance types of the region with equal or better vCPUs and memory
+// which are cheaper, or greener at no extra cost, than the current one
+func instanceAlternatives(vms provider.InstancesRegionOutput, current provider.InstanceRegionOutput) provider.InstancesRegionOutput {
+	res := provider.InstancesRegionOutput{}
+
+	for _, v := range vms {
+		if v.Sku == current.Sku || v.CpuArch != current.CpuArch {
+			continue
+		}
+		if v.VCpus < current.VCpus || v.Memory < current.Memory {
+			continue
+		}
+		if v.Price.Currency != current.Price.Currency {
+			continue
+		}
+
+		cheaper := v.GetCost() < current.GetCost()
+		greener := v.GetCost() <= current.GetCost() && isGreener(v, current)
+		if cheaper || greener {
+			res = append(res, v)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b provider.InstanceRegionOutput) int {
+		switch {
+		case a.GetCost() < b.GetCost():
+			return -1
+		case a.GetCost() > b.GetCost():
+			return 1
+		}
+		if isGreener(a, b) {
+			return -1
+		}
+		if isGreener(b, a) {
+			return 1
+		}
+		return 0
+	})
+
+	if len(res) > maxInstanceAlternatives {
+		res = res[:maxInstanceAlternatives]
+	}
+	return res
+}
+
+type roleInstance struct {
+	role string
+	vm   *provider.InstanceRegionOutput
+}
+
+// handleInstanceRightSizing offers cheaper or greener instance types in the same region
+// for every role and replaces the ones the user accepts
+func (k *KsctlCommand) handleInstanceRightSizing(meta *controllerMeta.Controller, m *controller.Metadata, roles ...roleInstance) error {
+	if m.Provider == consts.CloudLocal {
+		return nil
+	}
+
+	vms, err := k.instanceTypesInRegion(meta, m)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, r := range roles {
+		alternatives := instanceAlternatives(vms, *r.vm)
+		if len(alternatives) == 0 {
+			k.l.Debug(k.Ctx, "No instance type recommendation", "role", r.role, "instanceType", r.vm.Sku)
+			continue
+		}
+		found = true
+
+		sku, err := k.menuDriven.CardSelection(
+			cli.ConverterForInstanceRecommendationsForCards(r.role, *r.vm, alternatives),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get the instance type recommendation from user: %w", err)
+		}
+		if len(sku) == 0 {
+			continue
+		}
+
+		if v, ok := alternatives.Get(sku); ok {
+			k.l.Print(k.Ctx, "changed the instance type", "role", r.role, "from", color.HiRedString(r.vm.Sku), "to", color.HiGreenString(v.Sku))
+			*r.vm = *v
+		}
+	}
+
+	if !found {
+		k.l.Success(k.Ctx, "✨ No cheaper or greener instance types available in the region")
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+func testInstance(sku string, arch provider.MachineArch, vcpus, memory int, hourly float64) provider.InstanceRegionOutput {
+	return provider.InstanceRegionOutput{
+		Sku:     sku,
+		VCpus:   vcpus,
+		Memory:  memory,
+		CpuArch: arch,
+		Price:   provider.PriceHistory{HourlyPrice: hourly, Currency: "USD"},
+	}
+}
+
+func withEmbodied(vm provider.InstanceRegionOutput, co2 float64, unit string) provider.InstanceRegionOutput {
+	vm.EmboddedEmissions = &provider.EmboddedEmissions{EmboddedCo2: co2, Co2Unit: unit}
+	return vm
+}
+
+func TestRecommendNodePools(t *testing.T) {
+	vms := provider.InstancesRegionOutput{
+		testInstance("small", provider.ArchAmd64, 2, 4, 0.05),
+		testInstance("large", provider.ArchAmd64, 4, 16, 0.2),
+		testInstance("tiny", provider.ArchAmd64, 1, 1, 0.01),
+		testInstance("small-arm", provider.ArchArm64, 2, 4, 0.04),
+		testInstance("no-capacity", provider.ArchAmd64, 0, 0, 0.001),
+	}
+
+	type pool struct {
+		sku   string
+		count int
+	}
+
+	for _, tc := range []struct {
+		name string
+		w    workloadSpec
+		want []pool
+	}{
+		{
+			name: "exactly at the target utilisation",
+			w:    workloadSpec{CPU: 3, Memory: 6, Pods: 10, Arch: provider.ArchAmd64},
+			want: []pool{{"tiny", 8}, {"small", 2}, {"large", 1}},
+		},
+		{
+			name: "headroom rounds up the nodes",
+			w:    workloadSpec{CPU: 3.1, Memory: 6, Pods: 10, Arch: provider.ArchAmd64},
+			want: []pool{{"tiny", 8}, {"small", 3}, {"large", 2}},
+		},
+		{
+			name: "pods per node bound the pool",
+			w:    workloadSpec{CPU: 1, Memory: 1, Pods: 250, Arch: provider.ArchAmd64},
+			want: []pool{{"tiny", 3}, {"small", 3}, {"large", 3}},
+		},
+		{
+			name: "ha keeps a spare node",
+			w:    workloadSpec{CPU: 1, Memory: 1, Pods: 10, HA: true, Arch: provider.ArchAmd64},
+			want: []pool{{"tiny", 3}, {"small", 2}, {"large", 2}},
+		},
+		{
+			name: "architecture filters the instance types",
+			w:    workloadSpec{CPU: 3, Memory: 6, Pods: 10, Arch: provider.ArchArm64},
+			want: []pool{{"small-arm", 2}},
+		},
+		{
+			name: "any architecture",
+			w:    workloadSpec{CPU: 3, Memory: 6, Pods: 10},
+			want: []pool{{"small-arm", 2}, {"tiny", 8}, {"small", 2}, {"large", 1}},
+		},
+		{
+			name: "no instance type fits in a pool",
+			w:    workloadSpec{CPU: 1000, Memory: 6, Pods: 10},
+			want: []pool{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []pool{}
+			for _, r := range recommendNodePools(vms, tc.w) {
+				got = append(got, pool{r.Instance.Sku, r.Count})
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRecommendNodePoolsLimit(t *testing.T) {
+	vms := provider.InstancesRegionOutput{}
+	for i := range maxWorkloadRecommendations + 3 {
+		vms = append(vms, testInstance(string(rune('a'+i)), provider.ArchAmd64, 2, 4, float64(i+1)/100))
+	}
+
+	got := recommendNodePools(vms, workloadSpec{CPU: 1, Memory: 1, Pods: 1})
+	if len(got) != maxWorkloadRecommendations || got[0].Instance.Sku != "a" {
+		t.Errorf("got %d recommendations starting with %s, want the %d cheapest", len(got), got[0].Instance.Sku, maxWorkloadRecommendations)
+	}
+}
+
+func TestInstanceAlternatives(t *testing.T) {
+	current := withEmbodied(testInstance("current", provider.ArchAmd64, 2, 4, 0.10), 1000, "kgCO2eq")
+
+	otherCurrency := testInstance("other-currency", provider.ArchAmd64, 2, 4, 0.01)
+	otherCurrency.Price.Currency = "EUR"
+
+	for _, tc := range []struct {
+		name    string
+		current provider.InstanceRegionOutput
+		vms     provider.InstancesRegionOutput
+		want    []string
+	}{
+		{
+			name:    "cheaper with equal or more resources, cheapest first",
+			current: current,
+			vms: provider.InstancesRegionOutput{
+				current,
+				testInstance("bigger-cheaper", provider.ArchAmd64, 4, 8, 0.09),
+				testInstance("cheaper", provider.ArchAmd64, 2, 4, 0.08),
+				testInstance("smaller", provider.ArchAmd64, 1, 2, 0.02),
+				testInstance("pricier", provider.ArchAmd64, 2, 8, 0.2),
+			},
+			want: []string{"cheaper", "bigger-cheaper"},
+		},
+		{
+			name:    "other architectures and currencies are skipped",
+			current: current,
+			vms: provider.InstancesRegionOutput{
+				testInstance("arm", provider.ArchArm64, 2, 4, 0.05),
+				otherCurrency,
+			},
+			want: []string{},
+		},
+		{
+			name:    "greener at the same cost",
+			current: current,
+			vms: provider.InstancesRegionOutput{
+				withEmbodied(testInstance("dirtier", provider.ArchAmd64, 2, 4, 0.10), 2000, "kgCO2eq"),
+				withEmbodied(testInstance("greener", provider.ArchAmd64, 2, 4, 0.10), 500, "kgCO2eq"),
+			},
+			want: []string{"greener"},
+		},
+		{
+			name:    "embodied emissions are compared in the same unit",
+			current: current,
+			vms: provider.InstancesRegionOutput{
+				withEmbodied(testInstance("grams", provider.ArchAmd64, 2, 4, 0.10), 600000, "gCO2eq"),
+				withEmbodied(testInstance("tonnes", provider.ArchAmd64, 2, 4, 0.10), 2, "tCO2eq"),
+			},
+			want: []string{"grams"},
+		},
+		{
+			name:    "unknown embodied emissions fall back to the energy",
+			current: testInstance("current", provider.ArchAmd64, 2, 4, 0.10),
+			vms: provider.InstancesRegionOutput{
+				testInstance("same", provider.ArchAmd64, 2, 4, 0.10),
+				testInstance("bigger", provider.ArchAmd64, 4, 8, 0.10),
+			},
+			want: []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := []string{}
+			for _, v := range instanceAlternatives(tc.vms, tc.current) {
+				got = append(got, v.Sku)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
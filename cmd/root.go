@@ -57,6 +57,8 @@ func (k *KsctlCommand) NewRootCmd() *cobra.Command {
 
 			k.telemetry = telemetry.NewTelemetry(k.KsctlConfig.Telemetry)
 
+			k.setupCurrency()
+
 			cmdName := cmd.Name()
 			if cmdName != "self-update" && cmdName != "version" {
 				hasUpdates, err := k.CheckForUpdates()
@@ -64,6 +66,15 @@ func (k *KsctlCommand) NewRootCmd() *cobra.Command {
 					k.NotifyAvailableUpdates()
 				}
 			}
+
+			switch {
+			case cmdName == "reap", cmdName == "version", cmdName == "self-update", cmdName == cobra.ShellCompRequestCmd:
+			case cmd.HasParent() && cmd.Parent().Name() == "completion":
+			// the records are read from the state store which may be the one being configured
+			case cmd.HasParent() && cmd.Parent().Name() == "configure":
+			default:
+				k.warnExpiredClusters()
+			}
 		},
 	}
 
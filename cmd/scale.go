@@ -17,10 +17,13 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/errors"
@@ -39,7 +42,7 @@ func (k *KsctlCommand) ScaleUp() *cobra.Command {
 ksctl update scaleup --help
 		`,
 		Short: "Use to manually scaleup a selfmanaged cluster",
-		Long:  "It is used to manually scaleup a selfmanaged cluster",
+		Long:  "It is used to manually scaleup a selfmanaged cluster. Managed clusters can't be scaled as the ksctl core has no API to resize their node pool",
 
 		Run: func(cmd *cobra.Command, args []string) {
 			clusters, err := k.fetchSelfManagedClusters()
@@ -83,7 +86,7 @@ ksctl update scaleup --help
 
 			m := valueMaping[selectedCluster]
 
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterScaleUp, telemetry.TelemetryMeta{
+			if err := k.sendClusterTelemetry(m, telemetry.EventClusterScaleUp, telemetry.TelemetryMeta{
 				CloudProvider:     m.Provider,
 				StorageDriver:     m.StateLocation,
 				Region:            m.Region,
@@ -96,15 +99,18 @@ ksctl update scaleup --help
 
 			currWP := m.NoWP
 
-			v, ok := k.getCounterValue(
+			v, err := k.getCounterValue(
 				"Enter the desired number of worker nodes",
-				func(i int) bool {
-					return i > currWP
+				func(i int) error {
+					if i <= currWP {
+						return fmt.Errorf("must be more than the current %d worker nodes", currWP)
+					}
+					return inRange(1, maxNodesPerPool)(i)
 				},
-				currWP,
+				currWP+1,
 			)
-			if !ok {
-				k.l.Warn(k.Ctx, "Make sure the no of workernodes should be more than the current workernodes")
+			if err != nil {
+				k.l.Error("Failed to get userinput", "Reason", err)
 				os.Exit(1)
 			}
 
@@ -127,25 +133,25 @@ ksctl update scaleup --help
 				os.Exit(1)
 			}
 
-			category := provider.Unknown
-			if m.Provider != consts.CloudLocal {
-				category = k.handleInstanceCategorySelection()
+			wp, err := k.askWorkerInstanceType(metaClient, &m, "Select instance_type for Worker Nodes")
+			if err != nil {
+				k.l.Error("Failed to get the instance type", "Reason", err)
+				os.Exit(1)
 			}
 
-			wp := k.handleInstanceTypeSelection(metaClient, &m, category, "Select instance_type for Worker Nodes")
-
 			m.WorkerPlaneNodeType = wp.Sku
 
-			curr := "$"
-			if wp.Price.Currency == "USD" {
-				curr = "$"
-			} else if wp.Price.Currency == "INR" {
-				curr = "₹"
-			} else if wp.Price.Currency == "EUR" {
-				curr = "€"
+			if ok, err := k.confirmArchSupport(m, map[string]provider.MachineArch{roleWorkerPlane: wp.CpuArch}); err != nil {
+				k.l.Error("Failed to get userinput", "Reason", err)
+				os.Exit(1)
+			} else if !ok {
+				k.l.Error("Cluster is not scaled up", "Reason", "the releases of the cluster are not confirmed for the cpu architecture of the instance type")
+				os.Exit(1)
 			}
 
-			k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will +%s%.2f (%d X %s)", curr, float64(m.NoWP-currWP)*wp.GetCost(), m.NoWP-currWP, wp.Sku))
+			k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will +%s (%d X %s)", currency.Format(float64(m.NoWP-currWP)*wp.GetCost(), wp.Price.Currency), m.NoWP-currWP, wp.Sku))
+
+			k.enforceBudget(m, float64(m.NoWP-currWP)*wp.GetCost(), wp.Price.Currency)
 
 			// {
 			// 	cc := m
@@ -177,17 +183,28 @@ ksctl update scaleup --help
 			k.l.Success(k.Ctx, "Cluster workernode scaled up successfully")
 		},
 	}
+
+	k.addOverrideBudgetFlag(cmd)
+
 	return cmd
 }
 
 func (k *KsctlCommand) ScaleDown() *cobra.Command {
+	nodes := []string{}
+	drainTimeout := 5 * time.Minute
+	override := false
+
 	cmd := &cobra.Command{
 		Use: "scaledown",
 		Example: `
 ksctl update scaledown --help
+ksctl update scaledown --nodes demo-vm-wp-2 --drain-timeout 10m
 		`,
 		Short: "Use to manually scaledown a selfmanaged cluster",
-		Long:  "It is used to manually scaledown a selfmanaged cluster",
+		Long: "It is used to manually scaledown a selfmanaged cluster, the selected worker nodes are cordoned and drained " +
+			"respecting the PodDisruptionBudgets before they are removed. " +
+			"The ksctl core removes the worker nodes from the newest, so only the newest ones can be selected. " +
+			"Managed clusters can't be scaled as the ksctl core has no API to resize their node pool",
 
 		Run: func(cmd *cobra.Command, args []string) {
 			clusters, err := k.fetchSelfManagedClusters()
@@ -237,8 +254,10 @@ ksctl update scaledown --help
 			}
 
 			m := valueMaping[selectedCluster]
+			idx, _ := strconv.Atoi(selectedCluster)
+			workers := clusters[idx].WP
 
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterScaleDown, telemetry.TelemetryMeta{
+			if err := k.sendClusterTelemetry(m, telemetry.EventClusterScaleDown, telemetry.TelemetryMeta{
 				CloudProvider:     m.Provider,
 				StorageDriver:     m.StateLocation,
 				Region:            m.Region,
@@ -255,24 +274,37 @@ ksctl update scaledown --help
 			}
 
 			currWP := m.NoWP
-			if currWP == 0 {
+			if currWP == 0 || len(workers) == 0 {
 				k.l.Error("There is no worker node to scale down")
 				os.Exit(1)
 			}
 
-			v, ok := k.getCounterValue(
-				"Enter the desired number of worker nodes",
-				func(i int) bool {
-					return i < currWP && i >= 0
-				},
-				currWP,
-			)
-			if !ok {
-				k.l.Warn(k.Ctx, "Make sure the no of workernodes should be less than the current workernodes and not less than 0")
+			d, err := k.clusterNodeClient(k.Ctx, m)
+			if err != nil {
+				k.l.Error("Failed to connect to the cluster to drain the nodes", "Reason", err)
 				os.Exit(1)
 			}
 
-			m.NoWP = v
+			created, err := d.nodeCreation(k.Ctx)
+			if err != nil {
+				k.l.Error("Failed to get the nodes of the cluster", "Reason", err)
+				os.Exit(1)
+			}
+
+			if len(nodes) == 0 {
+				nodes = k.selectWorkerNodes(workers, created)
+			}
+			if err := removableWorkerNodes(workers, nodes); err != nil {
+				k.l.Error("Invalid worker nodes to remove", "Reason", err)
+				os.Exit(1)
+			}
+
+			m.NoWP = currWP - len(nodes)
+			if m.NoWP == 0 {
+				k.guardProtected(m, "scaledown to 0 worker nodes", override)
+			}
+
+			evicted := k.previewEvictions(d, nodes, created)
 
 			{
 				// for just showing the costs changes
@@ -290,17 +322,13 @@ ksctl update scaledown --help
 					os.Exit(1)
 				}
 
-				vms := strings.Split(cc.WorkerPlaneNodeType, ",")
-
 				g := map[string]struct {
 					Count int
 					VM    provider.InstanceRegionOutput
 				}{}
 
-				for i := cc.NoWP; i < len(vms); i++ {
-					vm := vms[i]
-
-					wp := k.getSpecificInstanceForScaledown(metaClient, cc.Region, vm)
+				for _, vm := range workers[len(workers)-len(nodes):] {
+					wp := k.getSpecificInstanceForScaledown(metaClient, cc.Region, vm.VMSize)
 					if _, ok := g[wp.Sku]; ok {
 						g[wp.Sku] = struct {
 							Count int
@@ -320,22 +348,18 @@ ksctl update scaledown --help
 					}
 				}
 
-				curr := "$"
+				code := ""
 				total := 0.0
 				vmSize := []string{}
 				for k, x := range g {
-					if x.VM.Price.Currency == "USD" {
-						curr = "$"
-					} else if x.VM.Price.Currency == "INR" {
-						curr = "₹"
-					} else if x.VM.Price.Currency == "EUR" {
-						curr = "€"
-					}
-					total += float64(x.Count) * x.VM.GetCost()
+					// amounts are summed in the display currency as the instances can come from different catalogs
+					v, c := currency.Convert(float64(x.Count)*x.VM.GetCost(), x.VM.Price.Currency)
+					total += v
+					code = c
 					vmSize = append(vmSize, fmt.Sprintf("(%d X %s)", x.Count, k))
 				}
 
-				k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will -%s%.2f <%s>", curr, total, strings.Join(vmSize, ",")))
+				k.l.Box(k.Ctx, "Updated Cost", fmt.Sprintf("Cost of the cluster will -%s <%s>", currency.Format(total, code), strings.Join(vmSize, ",")))
 
 				cc.NoWP -= currWP
 
@@ -348,6 +372,8 @@ ksctl update scaledown --help
 				os.Exit(1)
 			}
 
+			k.drainNodes(d, nodes, created, evicted, drainTimeout)
+
 			c, err := selfmanaged.NewController(
 				k.Ctx,
 				k.l,
@@ -368,13 +394,82 @@ ksctl update scaledown --help
 			k.l.Success(k.Ctx, "Cluster workernode scaled down successfully")
 		},
 	}
+
+	cmd.Flags().StringSliceVar(&nodes, "nodes", nil, "Names of the newest worker nodes to remove (default is asked)")
+	cmd.Flags().DurationVar(&drainTimeout, "drain-timeout", drainTimeout, "Time to wait for the drain of every node")
+	cmd.Flags().BoolVar(&override, overrideProtectionFlag, false, "Remove all the worker nodes even when the cluster is protected")
+
 	return cmd
 }
 
+// selectWorkerNodes asks which worker nodes to remove, the ksctl core removes the worker nodes
+// from the newest so the selection is asked again till it is the newest ones
+func (k *KsctlCommand) selectWorkerNodes(workers []provider.VMData, created map[string]time.Time) []string {
+	options := make(map[string]string, len(workers))
+	for _, vm := range workers {
+		age := "unknown"
+		if t, ok := created[vm.VMName]; ok {
+			age = time.Since(t).Round(time.Minute).String()
+		}
+		options[fmt.Sprintf("%s (%s, age %s)", vm.VMName, vm.VMSize, age)] = vm.VMName
+	}
+	k.l.Note(k.Ctx, "The worker nodes are removed from the newest, an older node can only be removed along with the newer ones")
+
+	for {
+		nodes, err := k.menuDriven.MultiSelect("Select the worker nodes to remove", options)
+		if err != nil {
+			k.l.Error("Failed to get userinput", "Reason", err)
+			os.Exit(1)
+		}
+		if err := removableWorkerNodes(workers, nodes); err != nil {
+			k.l.Warn(k.Ctx, "Invalid worker nodes to remove", "Reason", err)
+			continue
+		}
+		slices.SortFunc(nodes, func(a, b string) int {
+			return slices.IndexFunc(workers, func(vm provider.VMData) bool { return vm.VMName == a }) -
+				slices.IndexFunc(workers, func(vm provider.VMData) bool { return vm.VMName == b })
+		})
+		return nodes
+	}
+}
+
+// removableWorkerNodes checks the nodes can be removed, the ksctl core removes the newest
+// worker nodes so the selection has to be the last nodes of the worker plane
+func removableWorkerNodes(workers []provider.VMData, nodes []string) error {
+	if len(nodes) == 0 {
+		return fmt.Errorf("no worker node is selected")
+	}
+
+	idx := make(map[string]int, len(workers))
+	for i, vm := range workers {
+		idx[vm.VMName] = i
+	}
+
+	seen := map[string]bool{}
+	for _, n := range nodes {
+		i, ok := idx[n]
+		if !ok {
+			return fmt.Errorf("%s is not a worker node of the cluster", n)
+		}
+		if seen[n] {
+			return fmt.Errorf("%s is selected more than once", n)
+		}
+		seen[n] = true
+		if i < len(workers)-len(nodes) {
+			newest := []string{}
+			for _, vm := range workers[len(workers)-len(nodes):] {
+				newest = append(newest, vm.VMName)
+			}
+			return fmt.Errorf("only the newest worker nodes can be removed, select %s", strings.Join(newest, ", "))
+		}
+	}
+	return nil
+}
+
 func (k *KsctlCommand) fetchSelfManagedClusters() ([]provider.ClusterData, error) {
 	m := controller.Metadata{}
-	if v, ok := k.getSelectedStorageDriver(); !ok {
-		return nil, errors.NewError(errors.ErrInvalidStorageProvider)
+	if v, err := k.getSelectedStorageDriver(); err != nil {
+		return nil, errors.WrapError(errors.ErrInvalidStorageProvider, err)
 	} else {
 		m.StateLocation = v
 	}
@@ -0,0 +1,46 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+func TestRemovableWorkerNodes(t *testing.T) {
+	workers := []provider.VMData{{VMName: "wp-0"}, {VMName: "wp-1"}, {VMName: "wp-2"}}
+
+	for _, tc := range []struct {
+		name  string
+		nodes []string
+		ok    bool
+	}{
+		{"newest node", []string{"wp-2"}, true},
+		{"newest nodes in any order", []string{"wp-2", "wp-1"}, true},
+		{"all the nodes", []string{"wp-0", "wp-1", "wp-2"}, true},
+		{"nothing selected", nil, false},
+		{"older node alone", []string{"wp-0"}, false},
+		{"gap in the newest nodes", []string{"wp-0", "wp-2"}, false},
+		{"unknown node", []string{"cp-0"}, false},
+		{"node selected twice", []string{"wp-2", "wp-2"}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := removableWorkerNodes(workers, tc.nodes); (err == nil) != tc.ok {
+				t.Errorf("removableWorkerNodes(%v) = %v, want ok %v", tc.nodes, err, tc.ok)
+			}
+		})
+	}
+}
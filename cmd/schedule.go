@@ -0,0 +1,662 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/config"
+	"github.com/ksctl/cli/v2/pkg/cron"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/selfmanaged"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"github.com/spf13/cobra"
+)
+
+const (
+	week = 7 * 24 * time.Hour
+	// maxCatchUp bounds the minutes the scheduler goes back to when a tick is late,
+	// e.g. while the previous scaling was running or the machine was asleep
+	maxCatchUp = time.Hour
+	// scheduledDrainTimeout is the time the scheduler waits for the drain of every node
+	scheduledDrainTimeout = 10 * time.Minute
+	schedulerStartTimeout = 5 * time.Second
+	schedulerStopTimeout  = time.Minute
+)
+
+type scheduledRule struct {
+	config.ScaleRule
+	cron *cron.Schedule
+}
+
+func parseScaleSchedule(s *config.ScaleSchedule) ([]scheduledRule, error) {
+	res := make([]scheduledRule, 0, len(s.Rules))
+	for _, r := range s.Rules {
+		c, err := cron.Parse(r.Cron)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, scheduledRule{ScaleRule: r, cron: c})
+	}
+	return res, nil
+}
+
+// dueRule returns the rule which fires in the minute, the last one wins when several do
+func dueRule(rules []scheduledRule, t time.Time) (scheduledRule, bool) {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].cron.Matches(t) {
+			return rules[i], true
+		}
+	}
+	return scheduledRule{}, false
+}
+
+func peakWorkers(rules []scheduledRule) int {
+	peak := 0
+	for _, r := range rules {
+		peak = max(peak, r.Workers)
+	}
+	return peak
+}
+
+// averageWorkers simulates the rules over the week from the time, the count at the start is
+// the one set by the last rule fired in the week before
+func averageWorkers(rules []scheduledRule, from time.Time) float64 {
+	start := from.Truncate(time.Minute)
+
+	count := peakWorkers(rules)
+	for t := start.Add(-week); t.Before(start); t = t.Add(time.Minute) {
+		if r, ok := dueRule(rules, t); ok {
+			count = r.Workers
+		}
+	}
+
+	total, minutes := 0, 0
+	for t := start; t.Before(start.Add(week)); t = t.Add(time.Minute) {
+		if r, ok := dueRule(rules, t); ok {
+			count = r.Workers
+		}
+		total += count
+		minutes++
+	}
+	return float64(total) / float64(minutes)
+}
+
+// scheduleSavings is the projected monthly savings of the schedule compared to running the peak
+// number of worker nodes all the time
+func (k *KsctlCommand) scheduleSavings(pricing *instancePricing, c provider.ClusterData, s *config.ScaleSchedule, rules []scheduledRule) (float64, string, error) {
+	vm, err := pricing.instance(c, s.InstanceType)
+	if err != nil {
+		return 0, "", err
+	}
+	saved := (float64(peakWorkers(rules)) - averageWorkers(rules, time.Now())) * vm.GetCost()
+	v, code := currency.Convert(saved, vm.Price.Currency)
+	return v, code, nil
+}
+
+func (k *KsctlCommand) Schedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "schedule",
+		Example: `
+ksctl cluster schedule --help
+`,
+		Short: "Use to manage the scaling schedule of a selfmanaged cluster",
+		Long:  "It is used to plan the number of worker nodes of a selfmanaged cluster at the given times, the plan is run by $ksctl scheduler run",
+	}
+
+	return cmd
+}
+
+// parseScaleRules pairs the worker counts with the cron expressions of the "at <cron>" args
+func parseScaleRules(workers []int, args []string) ([]config.ScaleRule, error) {
+	if len(workers) == 0 {
+		return nil, fmt.Errorf("at least one --scale-workers <count> at \"<cron>\" is required")
+	}
+	if len(args) != 2*len(workers) {
+		return nil, fmt.Errorf("every --scale-workers needs one at \"<cron>\", got %d counts and %d args", len(workers), len(args))
+	}
+
+	res := make([]config.ScaleRule, 0, len(workers))
+	for i, w := range workers {
+		if args[2*i] != "at" {
+			return nil, fmt.Errorf("expected at before %q, got %q", args[2*i+1], args[2*i])
+		}
+		if err := inRange(0, maxNodesPerPool)(w); err != nil {
+			return nil, fmt.Errorf("invalid number of worker nodes %d: %w", w, err)
+		}
+		c, err := cron.Parse(args[2*i+1])
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, config.ScaleRule{Cron: c.String(), Workers: w})
+	}
+	return res, nil
+}
+
+func (k *KsctlCommand) ScheduleSet() *cobra.Command {
+	name, instanceType := "", ""
+	workers := []int{}
+
+	cmd := &cobra.Command{
+		Use: "set --scale-workers <count> at <cron> [--scale-workers <count> at <cron>...]",
+		Example: `
+ksctl cluster schedule set --name demo --scale-workers 0 at "0 20 * * 1-5" --scale-workers 3 at "0 8 * * 1-5"
+`,
+		Short: "Use to set the scaling schedule of a selfmanaged cluster",
+		Long: "It is used to set the number of worker nodes of the cluster at the times of the cron expressions, in the local time of the scheduler. " +
+			"The existing schedule of the cluster is replaced",
+		Run: func(cmd *cobra.Command, args []string) {
+			rules, err := parseScaleRules(workers, args)
+			if err != nil {
+				k.l.Error("Invalid schedule", "Reason", err)
+				os.Exit(1)
+			}
+
+			var names []string
+			if len(name) != 0 {
+				names = []string{name}
+			}
+			c, _ := k.selectSelfManagedCluster("Select the cluster to schedule", names)
+
+			if len(instanceType) == 0 {
+				if len(c.WP) == 0 {
+					k.l.Error("Cluster has no worker node to take the instance type from", "hint", "use --instance-type")
+					os.Exit(1)
+				}
+				instanceType = c.WP[len(c.WP)-1].VMSize
+			}
+
+			s := &config.ScaleSchedule{Rules: rules, InstanceType: instanceType}
+			parsed, _ := parseScaleSchedule(s)
+
+			if err := k.updateClusterRecord(k.clusterMetadata(c), func(r *config.ClusterRecord) {
+				r.Schedule = s
+			}); err != nil {
+				k.l.Error("Failed to save the schedule", "Reason", err)
+				os.Exit(1)
+			}
+
+			k.showSchedule(k.newInstancePricing(), c, s, parsed)
+			k.l.Success(k.Ctx, "Saved the schedule", "cluster", c.Name, "hint", "ksctl scheduler run --daemon")
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the cluster (default is asked)")
+	cmd.Flags().IntSliceVar(&workers, "scale-workers", nil, "Number of worker nodes, followed by at \"<cron>\"")
+	cmd.Flags().StringVar(&instanceType, "instance-type", "", "Instance type of the worker nodes added by the schedule (default is the one of the newest worker node)")
+
+	return cmd
+}
+
+func (k *KsctlCommand) showSchedule(pricing *instancePricing, c provider.ClusterData, s *config.ScaleSchedule, rules []scheduledRule) {
+	lines := []string{fmt.Sprintf("Cluster: %s (%d worker nodes of %s)", c.Name, c.NoWP, s.InstanceType)}
+	now := time.Now()
+	for _, r := range rules {
+		next := "never"
+		if t := r.cron.Next(now); !t.IsZero() {
+			next = t.Format(time.DateTime)
+		}
+		lines = append(lines, fmt.Sprintf("%d worker nodes at %q, next %s", r.Workers, r.cron, next))
+	}
+
+	lines = append(lines, fmt.Sprintf("Average worker nodes: %.1f of %d", averageWorkers(rules, now), peakWorkers(rules)))
+	if saved, code, err := k.scheduleSavings(pricing, c, s, rules); err != nil {
+		k.l.Warn(k.Ctx, "Unable to project the savings of the schedule", "Reason", err)
+	} else {
+		lines = append(lines, "Projected savings: "+currency.Format(saved, code)+"/month")
+	}
+
+	k.l.Box(k.Ctx, "Scaling Schedule", strings.Join(lines, "\n"))
+}
+
+func (k *KsctlCommand) ScheduleShow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "show [cluster]",
+		Example: `
+ksctl cluster schedule show
+ksctl cluster schedule show demo
+`,
+		Short: "Use to show the scaling schedules with their projected savings",
+		Long:  "It is used to show the scaling schedules of the clusters, their next runs and the projected monthly savings",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			clusters, err := k.fetchSelfManagedClusters()
+			if err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				os.Exit(1)
+			}
+			records := k.loadClusterRecords()
+			pricing := k.newInstancePricing()
+
+			found := false
+			for _, c := range clusters {
+				if len(args) != 0 && c.Name != args[0] {
+					continue
+				}
+				rec, ok := records.Get(clusterRecordKey(c))
+				if !ok || rec.Schedule == nil {
+					continue
+				}
+				rules, err := parseScaleSchedule(rec.Schedule)
+				if err != nil {
+					k.l.Warn(k.Ctx, "Invalid schedule", "cluster", c.Name, "Reason", err)
+					continue
+				}
+				found = true
+				k.showSchedule(pricing, c, rec.Schedule, rules)
+			}
+
+			if !found {
+				k.l.Print(k.Ctx, "No scaling schedules found")
+			}
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) ScheduleClear() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "clear [cluster]",
+		Example: `
+ksctl cluster schedule clear demo
+`,
+		Short: "Use to remove the scaling schedule of a selfmanaged cluster",
+		Long:  "It is used to remove the scaling schedule of the cluster, the worker nodes are left as they are",
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			c, _ := k.selectSelfManagedCluster("Select the cluster to clear the schedule of", args)
+
+			if err := k.updateClusterRecord(k.clusterMetadata(c), func(r *config.ClusterRecord) {
+				r.Schedule = nil
+			}); err != nil {
+				k.l.Error("Failed to clear the schedule", "Reason", err)
+				os.Exit(1)
+			}
+			k.l.Success(k.Ctx, "Cleared the schedule", "cluster", c.Name)
+		},
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) Scheduler() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "scheduler",
+		Example: `
+ksctl scheduler --help
+`,
+		Short: "Use to run the scaling schedules of the clusters",
+		Long:  "It is used to run the scaling schedules set by $ksctl cluster schedule set and to view the journal of the actions taken",
+	}
+
+	return cmd
+}
+
+func (k *KsctlCommand) SchedulerRun() *cobra.Command {
+	daemon := false
+
+	cmd := &cobra.Command{
+		Use: "run",
+		Example: `
+ksctl scheduler run
+ksctl scheduler run --daemon
+`,
+		Short: "Use to run the scaling schedules",
+		Long: "It is used to scale the worker nodes of the clusters at the times of their schedules, it runs in the foreground until interrupted. " +
+			"With --daemon it is started in the background with its log in the scheduler directory of the ksctl config",
+		Run: func(cmd *cobra.Command, args []string) {
+			if daemon {
+				k.startSchedulerDaemon()
+				return
+			}
+
+			unlock, err := config.LockScheduler()
+			if err != nil {
+				k.l.Error("Failed to start the scheduler", "Reason", err, "hint", "ksctl scheduler stop")
+				os.Exit(1)
+			}
+			defer unlock()
+
+			ctx, stop := signal.NotifyContext(k.Ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			k.l.Print(k.Ctx, "Scheduler started", "pid", os.Getpid())
+			last := time.Now().Truncate(time.Minute)
+			for {
+				next := last.Add(time.Minute)
+				select {
+				case <-ctx.Done():
+					k.l.Print(k.Ctx, "Scheduler stopped")
+					return
+				case <-time.After(time.Until(next)):
+				}
+
+				now := time.Now().Truncate(time.Minute)
+				t := next
+				if now.Sub(t) > maxCatchUp {
+					k.l.Warn(k.Ctx, "Scheduler was late, the older minutes are skipped", "from", t.Format(time.DateTime), "to", now.Add(-maxCatchUp).Format(time.DateTime))
+					t = now.Add(-maxCatchUp)
+				}
+				for ; !t.After(now); t = t.Add(time.Minute) {
+					k.runScheduledScaling(t)
+				}
+				last = now
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&daemon, "daemon", false, "Run the scheduler in the background")
+
+	return cmd
+}
+
+func (k *KsctlCommand) startSchedulerDaemon() {
+	if pid, running, err := config.RunningScheduler(); err != nil || running {
+		k.l.Error("Failed to start the scheduler", "Reason", cmp.Or(err, config.ErrSchedulerRunning), "pid", pid, "hint", "ksctl scheduler stop")
+		os.Exit(1)
+	}
+
+	dir, err := config.SchedulerDir()
+	if err != nil {
+		k.l.Error("Failed to locate the scheduler directory", "Reason", err)
+		os.Exit(1)
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		k.l.Error("Failed to locate the ksctl binary", "Reason", err)
+		os.Exit(1)
+	}
+
+	logPath := filepath.Join(dir, "scheduler.log")
+	logFile, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		k.l.Error("Failed to open the scheduler log", "Reason", err)
+		os.Exit(1)
+	}
+	defer logFile.Close()
+
+	c := exec.Command(exe, "scheduler", "run")
+	c.Stdout, c.Stderr = logFile, logFile
+	c.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := c.Start(); err != nil {
+		k.l.Error("Failed to start the scheduler", "Reason", err)
+		os.Exit(1)
+	}
+	_ = c.Process.Release()
+
+	// the scheduler takes the lock itself, another one started meanwhile wins
+	for deadline := time.Now().Add(schedulerStartTimeout); time.Now().Before(deadline); time.Sleep(200 * time.Millisecond) {
+		if pid, running, _ := config.RunningScheduler(); running && pid != 0 {
+			k.l.Success(k.Ctx, "Started the scheduler in the background", "pid", pid, "log", logPath)
+			return
+		}
+	}
+	k.l.Error("Scheduler didn't start", "hint", "see the log "+logPath)
+	os.Exit(1)
+}
+
+func (k *KsctlCommand) SchedulerStop() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "stop",
+		Example: `
+ksctl scheduler stop
+`,
+		Short: "Use to stop the running scheduler",
+		Long:  "It is used to stop the scheduler started with $ksctl scheduler run, the scaling it is running is finished first",
+		Run: func(cmd *cobra.Command, args []string) {
+			pid, running, err := config.RunningScheduler()
+			if err != nil {
+				k.l.Error("Failed to find the running scheduler", "Reason", err)
+				os.Exit(1)
+			}
+			if !running {
+				k.l.Note(k.Ctx, "Scheduler is not running")
+				return
+			}
+
+			if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
+				k.l.Error("Failed to stop the scheduler", "pid", pid, "Reason", err)
+				os.Exit(1)
+			}
+
+			for deadline := time.Now().Add(schedulerStopTimeout); time.Now().Before(deadline); time.Sleep(time.Second) {
+				if _, running, _ := config.RunningScheduler(); !running {
+					k.l.Success(k.Ctx, "Stopped the scheduler", "pid", pid)
+					return
+				}
+			}
+			k.l.Warn(k.Ctx, "Scheduler is still finishing the running scaling, it stops once done", "pid", pid)
+		},
+	}
+
+	return cmd
+}
+
+// runScheduledScaling scales the clusters which have a rule firing in the minute
+func (k *KsctlCommand) runScheduledScaling(t time.Time) {
+	records := k.loadClusterRecords()
+	pricing := k.newInstancePricing()
+
+	var clusters []provider.ClusterData
+	for key, rec := range records.Clusters {
+		if rec.Schedule == nil {
+			continue
+		}
+		rules, err := parseScaleSchedule(rec.Schedule)
+		if err != nil {
+			k.l.Warn(k.Ctx, "Invalid schedule", "cluster", rec.Name, "Reason", err)
+			continue
+		}
+		r, ok := dueRule(rules, t)
+		if !ok {
+			continue
+		}
+
+		if clusters == nil {
+			if clusters, err = k.fetchSelfManagedClusters(); err != nil {
+				k.l.Error("Error in fetching the clusters", "Error", err)
+				return
+			}
+		}
+
+		e := config.JournalEntry{
+			Time:          time.Now().UTC(),
+			Cluster:       rec.Name,
+			CloudProvider: rec.CloudProvider,
+			Region:        rec.Region,
+			Rule:          r.Cron,
+			To:            r.Workers,
+		}
+
+		i := slices.IndexFunc(clusters, func(c provider.ClusterData) bool { return clusterRecordKey(c) == key })
+		if i < 0 {
+			e.Action, e.Error = "skip", "cluster not found"
+		} else {
+			c := clusters[i]
+			e.From = c.NoWP
+			switch {
+			case r.Workers == 0 && rec.Protected:
+				e.Action, e.Error = "skip", "cluster is protected"
+			case r.Workers == c.NoWP:
+				e.Action = "unchanged"
+			case r.Workers > c.NoWP:
+				e.Action = "scaleup"
+			default:
+				e.Action = "scaledown"
+			}
+			if e.Action == "scaleup" || e.Action == "scaledown" {
+				k.l.Print(k.Ctx, "Scaling the worker nodes", "cluster", c.Name, "from", c.NoWP, "to", r.Workers, "rule", r.Cron)
+				if err := k.scheduledScale(pricing, c, r.Workers, rec.Schedule.InstanceType); err != nil {
+					e.Error = err.Error()
+				}
+			}
+		}
+
+		if len(e.Error) != 0 {
+			k.l.Warn(k.Ctx, "Scheduled scaling failed", "cluster", e.Cluster, "Reason", e.Error)
+		} else {
+			k.l.Print(k.Ctx, "Scheduled scaling done", "cluster", e.Cluster, "action", e.Action, "workers", e.To)
+		}
+		if err := config.AppendJournal(e); err != nil {
+			k.l.Warn(k.Ctx, "Failed to write the scheduler journal", "Reason", err)
+		}
+	}
+}
+
+// scheduledScale changes the number of worker nodes without prompting, the removed nodes are drained first
+// and the added ones have to fit in the budget. The credentials are loaded into a context of the run
+// so that k.Ctx doesn't grow with every firing of the long running scheduler
+func (k *KsctlCommand) scheduledScale(pricing *instancePricing, c provider.ClusterData, target int, sku string) error {
+	m := k.clusterMetadata(c)
+	ctx, err := k.withCloudProviderCreds(k.Ctx, m.Provider)
+	if err != nil {
+		return err
+	}
+
+	if target > c.NoWP && k.KsctlConfig.Budget.IsConfigured() {
+		vm, err := pricing.instance(c, sku)
+		switch {
+		case err == nil:
+			err = k.checkBudget(m, float64(target-c.NoWP)*vm.GetCost(), vm.Price.Currency)
+		case k.KsctlConfig.Budget.IsBlocking():
+			err = fmt.Errorf("unable to verify the blocking budget: %w", err)
+		default:
+			k.l.Warn(k.Ctx, "Unable to check the budget", "Reason", err)
+			err = nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if target < c.NoWP && target < len(c.WP) {
+		nodes := []string{}
+		for _, vm := range c.WP[target:] {
+			nodes = append(nodes, vm.VMName)
+		}
+		if err := k.drainForSchedule(ctx, m, nodes); err != nil {
+			return err
+		}
+	}
+
+	m.NoWP = target
+	m.WorkerPlaneNodeType = sku
+	sc, err := selfmanaged.NewController(ctx, k.l, &controller.Client{Metadata: m})
+	if err != nil {
+		return err
+	}
+	if target > c.NoWP {
+		return sc.AddWorkerNodes()
+	}
+	return sc.DeleteWorkerNodes()
+}
+
+func (k *KsctlCommand) drainForSchedule(ctx context.Context, m controller.Metadata, nodes []string) error {
+	d, err := k.clusterNodeClient(ctx, m)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the cluster to drain the nodes: %w", err)
+	}
+	created, err := d.nodeCreation(k.Ctx)
+	if err != nil {
+		return err
+	}
+
+	present, evicted := []string{}, []evictedPod{}
+	for _, n := range nodes {
+		if _, ok := created[n]; !ok {
+			continue
+		}
+		pods, err := d.podsToEvict(k.Ctx, n)
+		if err != nil {
+			return err
+		}
+		present = append(present, n)
+		evicted = append(evicted, pods...)
+	}
+
+	return d.drainAll(k.Ctx, present, evicted, scheduledDrainTimeout, func(n string, pods int) {
+		k.l.Print(k.Ctx, "Draining the node", "node", n, "pods", pods)
+	})
+}
+
+func (k *KsctlCommand) SchedulerJournal() *cobra.Command {
+	output := ""
+	clusterName := ""
+
+	cmd := &cobra.Command{
+		Use: "journal",
+		Example: `
+ksctl scheduler journal
+ksctl scheduler journal --name demo --output json
+`,
+		Short: "Use to view the actions taken by the scheduler",
+		Long:  "It is used to view the journal of the scheduled scaling, every rule fired is recorded with its result",
+		Run: func(cmd *cobra.Command, args []string) {
+			entries, err := config.ReadJournal()
+			if err != nil {
+				k.l.Error("Failed to read the scheduler journal", "Reason", err)
+				os.Exit(1)
+			}
+			if len(clusterName) != 0 {
+				entries = slices.DeleteFunc(entries, func(e config.JournalEntry) bool { return e.Cluster != clusterName })
+			}
+
+			if output == cli.OutputJson {
+				if err := printJson(entries); err != nil {
+					k.l.Error("Failed to print the journal", "Reason", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			if len(entries) == 0 {
+				k.l.Print(k.Ctx, "No scheduled actions found")
+				return
+			}
+
+			rows := make([][]string, 0, len(entries))
+			for _, e := range entries {
+				status := "ok"
+				if len(e.Error) != 0 {
+					status = e.Error
+				}
+				rows = append(rows, []string{
+					e.Time.Local().Format(time.DateTime), e.Cluster, e.Action,
+					strconv.Itoa(e.From), strconv.Itoa(e.To), e.Rule, status,
+				})
+			}
+			k.l.Table(k.Ctx, []string{"Time", "Cluster", "Action", "From", "To", "Rule", "Status"}, rows)
+		},
+	}
+
+	cli.AddOutputFormatFlag(cmd, &output, cli.OutputTable, cli.OutputJson)
+	cmd.Flags().StringVarP(&clusterName, "name", "n", "", "Name of the cluster")
+
+	return cmd
+}
@@ -1,25 +1,29 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
-	"strconv"
+	"sync"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
 	"github.com/ksctl/cli/v2/pkg/telemetry"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/common"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
 	"github.com/spf13/cobra"
 )
 
 func (k *KsctlCommand) Summary() *cobra.Command {
+	bulk := bulkOptions{}
 
 	cmd := &cobra.Command{
 		Use: "summary",
 		Example: `
 ksctl cluster summary --help
+ksctl cluster summary --selector team=payments
 		`,
 		Short: "Use to get summary of the created cluster",
-		Long:  "It is used to get summary cluster",
+		Long:  "It is used to get summary cluster, or of many clusters picked with --selector, --all or from the list",
 
 		Run: func(cmd *cobra.Command, args []string) {
 			clusters, err := k.fetchAllClusters()
@@ -33,73 +37,89 @@ ksctl cluster summary --help
 				os.Exit(1)
 			}
 
-			selectDisplay := make(map[string]string, len(clusters))
-			valueMaping := make(map[string]controller.Metadata, len(clusters))
-
-			for idx, cluster := range clusters {
-				selectDisplay[makeHumanReadableList(cluster)] = strconv.Itoa(idx)
-				valueMaping[strconv.Itoa(idx)] = controller.Metadata{
-					ClusterName:   cluster.Name,
-					ClusterType:   cluster.ClusterType,
-					Provider:      cluster.CloudProvider,
-					Region:        cluster.Region,
-					StateLocation: k.KsctlConfig.PreferedStateStore,
-					K8sDistro:     cluster.K8sDistro,
-					K8sVersion:    cluster.K8sVersion,
-				}
-			}
-
-			selectedCluster, err := k.menuDriven.DropDown(
-				"Select the cluster to for summary",
-				selectDisplay,
-			)
-			if err != nil {
-				k.l.Error("Failed to get userinput", "Reason", err)
+			targets, ok := k.targetClusters("Select the clusters for summary", clusters, bulk)
+			if !ok {
 				os.Exit(1)
 			}
 
-			m := valueMaping[selectedCluster]
-
-			if err := k.telemetry.Send(k.Ctx, k.l, telemetry.EventClusterConnect, telemetry.TelemetryMeta{
-				CloudProvider:     m.Provider,
-				StorageDriver:     m.StateLocation,
-				Region:            m.Region,
-				ClusterType:       m.ClusterType,
-				BootstrapProvider: m.K8sDistro,
-				K8sVersion:        m.K8sVersion,
-				Addons:            telemetry.TranslateMetadata(m.Addons),
-			}); err != nil {
-				k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
-			}
-
-			if k.loadCloudProviderCreds(m.Provider) != nil {
+			if !k.loadBulkCreds(targets) {
 				os.Exit(1)
 			}
 
-			c, err := common.NewController(
-				k.Ctx,
-				k.l,
-				&controller.Client{
-					Metadata: m,
-				},
-			)
-			if err != nil {
-				k.l.Error("Failed to create the controller", "Reason", err)
-				os.Exit(1)
+			if len(targets) == 1 {
+				health, err := k.clusterSummary(k.clusterMetadata(targets[0]))
+				if err != nil {
+					k.l.Error("Failed to connect to the cluster", "Reason", err)
+					os.Exit(1)
+				}
+				printClusterSummary(health)
+				return
 			}
 
-			health, err := c.ClusterSummary()
-			if err != nil {
-				k.l.Error("Failed to connect to the cluster", "Reason", err)
-				os.Exit(1)
+			// the summaries are printed once all of them are collected so that they don't interleave
+			summaries := make(map[string]*common.SummaryOutput, len(targets))
+			mu := sync.Mutex{}
+			results := k.runBulk("summary", targets, bulk.concurrency, func(c provider.ClusterData) (string, error) {
+				health, err := k.clusterSummary(k.clusterMetadata(c))
+				if err != nil {
+					return "", err
+				}
+				mu.Lock()
+				summaries[clusterRecordKey(c)] = health
+				mu.Unlock()
+				return summaryDetail(health), nil
+			})
+
+			for _, c := range targets {
+				if health, ok := summaries[clusterRecordKey(c)]; ok {
+					printClusterSummary(health)
+				}
 			}
-			printClusterSummary(health)
+			k.reportBulk("summary", results)
 		},
 	}
 
+	addBulkFlags(cmd, &bulk)
+
 	return cmd
 }
 
+func (k *KsctlCommand) clusterSummary(m controller.Metadata) (*common.SummaryOutput, error) {
+	if err := k.sendClusterTelemetry(m, telemetry.EventClusterConnect, telemetry.TelemetryMeta{
+		CloudProvider:     m.Provider,
+		StorageDriver:     m.StateLocation,
+		Region:            m.Region,
+		ClusterType:       m.ClusterType,
+		BootstrapProvider: m.K8sDistro,
+		K8sVersion:        m.K8sVersion,
+		Addons:            telemetry.TranslateMetadata(m.Addons),
+	}); err != nil {
+		k.l.Debug(k.Ctx, "Failed to send the telemetry", "Reason", err)
+	}
+
+	c, err := common.NewController(
+		k.Ctx,
+		k.l,
+		&controller.Client{
+			Metadata: m,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the controller: %w", err)
+	}
+
+	return c.ClusterSummary()
+}
+
+// summaryDetail is the health of the cluster in the results of many clusters
+func summaryDetail(s *common.SummaryOutput) string {
+	health := "apiserver healthy"
+	if s.APIServerHealthCheck != nil && !s.APIServerHealthCheck.Healthy {
+		health = "apiserver unhealthy"
+	}
+	return fmt.Sprintf("%s, %d nodes, %d issues", health, len(s.Nodes), len(s.DetectedIssues))
+}
+
 func printClusterSummary(summary *common.SummaryOutput) {
 	cli.NewSummaryUI(os.Stdout).RenderClusterSummary(summary)
 }
@@ -21,25 +21,21 @@ import (
 	"strings"
 
 	"github.com/ksctl/cli/v2/pkg/cli"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/provider"
 )
 
-func (k *KsctlCommand) getClusterName() (string, bool) {
-	v, err := k.menuDriven.TextInput("Enter Cluster Name")
+func (k *KsctlCommand) getClusterName() (string, error) {
+	v, err := k.menuDriven.TextInput("Enter Cluster Name", cli.WithValidator(k.validateNewClusterName()))
 	if err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
-	}
-	if len(v) == 0 {
-		k.l.Error("Cluster name cannot be empty")
-		return "", false
+		return "", err
 	}
 	k.l.Debug(k.Ctx, "Text input", "clusterName", v)
-	return v, true
+	return v, nil
 }
 
-func (k *KsctlCommand) getBootstrap() (consts.KsctlKubernetes, bool) {
+func (k *KsctlCommand) getBootstrap() (consts.KsctlKubernetes, error) {
 	v, err := k.menuDriven.DropDown(
 		"Select the bootstrap type",
 		map[string]string{
@@ -49,51 +45,74 @@ func (k *KsctlCommand) getBootstrap() (consts.KsctlKubernetes, bool) {
 		cli.WithDefaultValue(string(consts.K8sK3s)),
 	)
 	if err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	}
 	k.l.Debug(k.Ctx, "DropDown input", "bootstrapType", v)
-	return consts.KsctlKubernetes(v), true
+	return consts.KsctlKubernetes(v), nil
 }
 
-type userInputValidation func(int) bool
+// userInputValidation returns the reason the value is not accepted
+type userInputValidation func(int) error
 
-func (k *KsctlCommand) getCounterValue(prompt string, validate userInputValidation, defaultVal int) (int, bool) {
-	v, err := k.menuDriven.TextInput(prompt, cli.WithDefaultValue(strconv.Itoa(defaultVal)))
+// getCounterValue re-prompts till the value is an integer accepted by validate
+func (k *KsctlCommand) getCounterValue(prompt string, validate userInputValidation, defaultVal int) (int, error) {
+	v, err := k.menuDriven.TextInput(
+		prompt,
+		cli.WithDefaultValue(strconv.Itoa(defaultVal)),
+		cli.WithValidator(func(s string) error {
+			_v, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("%q is not a whole number", s)
+			}
+			return validate(_v)
+		}),
+	)
 	if err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return 0, false
+		return 0, err
 	}
-	_v, err := strconv.Atoi(v)
+	_v, _ := strconv.Atoi(strings.TrimSpace(v))
+
+	k.l.Debug(k.Ctx, "Text input", "counterValue", v)
+	return _v, nil
+}
+
+// getFloatValue re-prompts till the value is a number accepted by validate
+func (k *KsctlCommand) getFloatValue(prompt string, validate func(float64) error, defaultVal float64) (float64, error) {
+	v, err := k.menuDriven.TextInput(
+		prompt,
+		cli.WithDefaultValue(strconv.FormatFloat(defaultVal, 'f', -1, 64)),
+		cli.WithValidator(func(s string) error {
+			_v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				return fmt.Errorf("%q is not a number", s)
+			}
+			return validate(_v)
+		}),
+	)
 	if err != nil {
-		k.l.Error("Invalid input", "Reason", err)
-		return 0, false
+		return 0, err
 	}
+	_v, _ := strconv.ParseFloat(strings.TrimSpace(v), 64)
 
-	if !validate(_v) {
-		k.l.Error("Invalid input")
-		return 0, false
-	}
-	k.l.Debug(k.Ctx, "Text input", "counterValue", v)
-	return _v, true
+	k.l.Debug(k.Ctx, "Text input", "floatValue", v)
+	return _v, nil
 }
 
-func (k *KsctlCommand) getSelectedRegion(regions provider.RegionsOutput) (string, bool) {
+func (k *KsctlCommand) getSelectedRegion(regions provider.RegionsOutput) (string, error) {
 	k.l.Debug(k.Ctx, "Regions", "regions", regions)
 
 	if v, err := k.menuDriven.DropDown(
 		"Select the region",
 		CliRegions(regions).S(),
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "region", v)
-		return v, true
+		return v, nil
 	}
 }
 
-func (k *KsctlCommand) getSelectedInstanceCategory(categories map[string]provider.MachineCategory) (provider.MachineCategory, bool) {
+func (k *KsctlCommand) getSelectedInstanceCategory(categories map[string]provider.MachineCategory) (provider.MachineCategory, error) {
 	k.l.Debug(k.Ctx, "Instance categories", "categories", categories)
 
 	vr := make(map[string]string, len(categories))
@@ -108,15 +127,39 @@ func (k *KsctlCommand) getSelectedInstanceCategory(categories map[string]provide
 		"Let us know about your workload type",
 		vr,
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "instanceCategory", v)
-		return provider.MachineCategory(v), true
+		return provider.MachineCategory(v), nil
+	}
+}
+
+// archAny matches the instance types of every architecture
+const archAny = "any"
+
+func (k *KsctlCommand) getSelectedInstanceArch(prompt string, extra map[string]string) (string, error) {
+	options := map[string]string{
+		"amd64 (x86_64)":                   string(provider.ArchAmd64),
+		"arm64 (Graviton, Ampere, Cobalt)": string(provider.ArchArm64),
+		"Any architecture":                 archAny,
+	}
+	for k, v := range extra {
+		options[k] = v
+	}
+
+	if v, err := k.menuDriven.DropDown(
+		prompt,
+		options,
+		cli.WithDefaultValue(string(provider.ArchAmd64)),
+	); err != nil {
+		return "", err
+	} else {
+		k.l.Debug(k.Ctx, "DropDown input", "arch", v)
+		return v, nil
 	}
 }
 
-func (k *KsctlCommand) getSelectedK8sVersion(prompt string, vers []string) (string, bool) {
+func (k *KsctlCommand) getSelectedK8sVersion(prompt string, vers []string) (string, error) {
 	k.l.Debug(k.Ctx, "List of k8s versions", "versions", vers)
 
 	if v, err := k.menuDriven.DropDownList(
@@ -124,11 +167,10 @@ func (k *KsctlCommand) getSelectedK8sVersion(prompt string, vers []string) (stri
 		vers,
 		cli.WithDefaultValue(vers[0]),
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "k8sVersion", v)
-		return v, true
+		return v, nil
 	}
 }
 
@@ -172,26 +214,24 @@ type CliInstances provider.InstancesRegionOutput
 func (I CliInstances) S() map[string]string {
 	m := make(map[string]string, len(I))
 	for _, vm := range I {
-		if vm.CpuArch == provider.ArchAmd64 {
-			displayName := fmt.Sprintf("%s (vCPUs: %d, Memory: %dGB)",
-				vm.Description,
-				vm.VCpus,
-				vm.Memory,
-			)
-			displayName += fmt.Sprintf(", Price: %.2f %s/month",
-				vm.GetCost(),
-				vm.Price.Currency,
-			)
-
-			if vm.EmboddedEmissions != nil {
-				displayName += fmt.Sprintf(", Embodied Emission: %.2f %s",
-					vm.EmboddedEmissions.EmboddedCo2,
-					vm.EmboddedEmissions.Co2Unit,
-				)
-			}
+		displayName := fmt.Sprintf("%s (vCPUs: %d, Memory: %dGB, Arch: %s)",
+			vm.Description,
+			vm.VCpus,
+			vm.Memory,
+			vm.CpuArch,
+		)
+		displayName += fmt.Sprintf(", Price: %s/month",
+			currency.Format(vm.GetCost(), vm.Price.Currency),
+		)
 
-			m[displayName] = vm.Sku
+		if vm.EmboddedEmissions != nil {
+			displayName += fmt.Sprintf(", Embodied Emission: %.2f %s",
+				vm.EmboddedEmissions.EmboddedCo2,
+				vm.EmboddedEmissions.Co2Unit,
+			)
 		}
+
+		m[displayName] = vm.Sku
 	}
 	return m
 }
@@ -199,7 +239,7 @@ func (I CliInstances) S() map[string]string {
 func (k *KsctlCommand) getSelectedInstanceType(
 	prompt string,
 	vms provider.InstancesRegionOutput,
-) (string, bool) {
+) (string, error) {
 	vr := CliInstances(vms).S()
 
 	k.l.Debug(k.Ctx, "Instance types", "vms", vr)
@@ -208,24 +248,22 @@ func (k *KsctlCommand) getSelectedInstanceType(
 		prompt,
 		vr,
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "instanceType", v)
-		return v, true
+		return v, nil
 	}
 }
 
 func (k *KsctlCommand) getSelectedManagedClusterOffering(
 	prompt string,
 	offerings map[string]provider.ManagedClusterOutput,
-) (string, bool) {
+) (string, error) {
 	vr := make(map[string]string, len(offerings))
 	for _, o := range offerings {
-		displayName := fmt.Sprintf("%s, Price: %.2f %s/month",
+		displayName := fmt.Sprintf("%s, Price: %s/month",
 			o.Description,
-			o.GetCost(),
-			o.Price.Currency,
+			currency.Format(o.GetCost(), o.Price.Currency),
 		)
 
 		vr[displayName] = o.Sku
@@ -237,15 +275,14 @@ func (k *KsctlCommand) getSelectedManagedClusterOffering(
 		prompt,
 		vr,
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "managedClusterOffering", v)
-		return v, true
+		return v, nil
 	}
 }
 
-func (k *KsctlCommand) getSelectedClusterType() (consts.KsctlClusterType, bool) {
+func (k *KsctlCommand) getSelectedClusterType() (consts.KsctlClusterType, error) {
 	if v, err := k.menuDriven.DropDown(
 		"Select the cluster type",
 		map[string]string{
@@ -254,15 +291,14 @@ func (k *KsctlCommand) getSelectedClusterType() (consts.KsctlClusterType, bool)
 		},
 		cli.WithDefaultValue(string(consts.ClusterTypeMang)),
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "clusterType", v)
-		return consts.KsctlClusterType(v), true
+		return consts.KsctlClusterType(v), nil
 	}
 }
 
-func (k *KsctlCommand) getSelectedCloudProvider(v consts.KsctlClusterType) (consts.KsctlCloud, bool) {
+func (k *KsctlCommand) getSelectedCloudProvider(v consts.KsctlClusterType) (consts.KsctlCloud, error) {
 	options := map[string]string{
 		"Amazon Web Services": string(consts.CloudAws),
 		"Azure":               string(consts.CloudAzure),
@@ -276,52 +312,59 @@ func (k *KsctlCommand) getSelectedCloudProvider(v consts.KsctlClusterType) (cons
 		"Select the cloud provider",
 		options,
 	); err != nil {
-		k.l.Error("Failed to get userinput", "Reason", err)
-		return "", false
+		return "", err
 	} else {
 		k.l.Debug(k.Ctx, "DropDown input", "cloudProvider", v)
 
 		if err := k.loadCloudProviderCreds(consts.KsctlCloud(v)); err != nil {
-			return "", false
+			return "", err
 		}
 
-		return consts.KsctlCloud(v), true
+		return consts.KsctlCloud(v), nil
 	}
 }
 
 func (k *KsctlCommand) loadCloudProviderCreds(v consts.KsctlCloud) error {
+	ctx, err := k.withCloudProviderCreds(k.Ctx, v)
+	if err != nil {
+		return err
+	}
+	k.Ctx = ctx
+	return nil
+}
+
+// withCloudProviderCreds returns ctx carrying the credentials of the cloud provider, k.Ctx is left untouched
+func (k *KsctlCommand) withCloudProviderCreds(ctx context.Context, v consts.KsctlCloud) (context.Context, error) {
 	switch v {
 	case consts.CloudAws:
 		if v, err := k.loadAwsCredentials(); err != nil {
 			k.l.Error("Failed to load the AWS credentials", "Reason", err)
-			return err
+			return nil, err
 		} else {
-			k.Ctx = context.WithValue(k.Ctx, consts.KsctlAwsCredentials, v)
+			ctx = context.WithValue(ctx, consts.KsctlAwsCredentials, v)
 		}
 
 	case consts.CloudAzure:
 		if v, err := k.loadAzureCredentials(); err != nil {
 			k.l.Error("Failed to load the Azure credentials", "Reason", err)
-			return err
+			return nil, err
 		} else {
-			k.Ctx = context.WithValue(k.Ctx, consts.KsctlAzureCredentials, v)
+			ctx = context.WithValue(ctx, consts.KsctlAzureCredentials, v)
 		}
 	}
-	return nil
+	return ctx, nil
 }
 
-func (k *KsctlCommand) getSelectedStorageDriver() (consts.KsctlStore, bool) {
+func (k *KsctlCommand) getSelectedStorageDriver() (consts.KsctlStore, error) {
 	if k.KsctlConfig.PreferedStateStore != consts.StoreExtMongo && k.KsctlConfig.PreferedStateStore != consts.StoreLocal {
-		k.l.Error("Failed to determine StorageDriver", "message", "Please use $ksctl configure to set the storage driver", "currentSetValue", k.KsctlConfig.PreferedStateStore)
-		return "", false
+		return "", fmt.Errorf("unknown storage driver %q, please use $ksctl configure to set the storage driver", k.KsctlConfig.PreferedStateStore)
 	}
 
 	if k.KsctlConfig.PreferedStateStore == consts.StoreExtMongo {
 		if errS := k.loadMongoCredentials(); errS != nil {
-			k.l.Error("Failed to load the MongoDB credentials", "Reason", errS)
-			return "", false
+			return "", fmt.Errorf("failed to load the MongoDB credentials: %w", errS)
 		}
 	}
 
-	return k.KsctlConfig.PreferedStateStore, true
+	return k.KsctlConfig.PreferedStateStore, nil
 }
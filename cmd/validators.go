@@ -0,0 +1,161 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const maxClusterNameLength = 63
+
+var dns1123LabelRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// validateDNS1123Name checks the name is a valid DNS-1123 label as the cluster name
+// is used in the names of the cloud resources and the kubeconfig
+func validateDNS1123Name(name string) error {
+	switch {
+	case len(name) == 0:
+		return fmt.Errorf("name cannot be empty")
+	case len(name) > maxClusterNameLength:
+		return fmt.Errorf("name must be at most %d characters, got %d", maxClusterNameLength, len(name))
+	case !dns1123LabelRegex.MatchString(name):
+		return fmt.Errorf("name must consist of lowercase letters, digits and '-', and start and end with a letter or digit")
+	}
+	return nil
+}
+
+// validateNewClusterName checks the name is valid and not used by any of the existing clusters
+func (k *KsctlCommand) validateNewClusterName() func(string) error {
+	existing, err := k.fetchAllClusters()
+	if err != nil {
+		k.l.Debug(k.Ctx, "Unable to check the cluster name is not in use", "Reason", err)
+	}
+	return newClusterNameValidator(existing)
+}
+
+func newClusterNameValidator(existing []provider.ClusterData) func(string) error {
+	return func(name string) error {
+		if err := validateDNS1123Name(name); err != nil {
+			return err
+		}
+		for _, c := range existing {
+			if c.Name == name {
+				return fmt.Errorf("cluster %s already exists, it is a %s cluster on %s", name, c.ClusterType, c.CloudProvider)
+			}
+		}
+		return nil
+	}
+}
+
+func atLeast(min int) userInputValidation {
+	return func(v int) error {
+		if v < min {
+			return fmt.Errorf("must be at least %d", min)
+		}
+		return nil
+	}
+}
+
+func inRange(min, max int) userInputValidation {
+	return func(v int) error {
+		if v < min || v > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+// oddQuorum accepts the odd counts of at least min, an even number of members
+// tolerates no more failures than one less member
+func oddQuorum(min int) userInputValidation {
+	return func(v int) error {
+		if err := atLeast(min)(v); err != nil {
+			return err
+		}
+		if v%2 == 0 {
+			return fmt.Errorf("must be odd to keep the quorum, %d members tolerate as many failures as %d", v, v-1)
+		}
+		return nil
+	}
+}
+
+func positiveFloat(v float64) error {
+	if v <= 0 {
+		return fmt.Errorf("must be greater than 0")
+	}
+	return nil
+}
+
+// parseLabels parses the comma separated key=value node labels
+func parseLabels(v string) (map[string]string, error) {
+	res := map[string]string{}
+	for _, l := range strings.Split(v, ",") {
+		l = strings.TrimSpace(l)
+		if len(l) == 0 {
+			continue
+		}
+		key, val, ok := strings.Cut(l, "=")
+		if !ok {
+			return nil, fmt.Errorf("label %q must be in the form key=value", l)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			return nil, fmt.Errorf("label key %q is invalid: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(val); len(errs) != 0 {
+			return nil, fmt.Errorf("label value %q is invalid: %s", val, strings.Join(errs, "; "))
+		}
+		res[key] = val
+	}
+	return res, nil
+}
+
+var taintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// parseTaints parses the comma separated key=value:Effect or key:Effect node taints
+func parseTaints(v string) ([]string, error) {
+	res := []string{}
+	for _, t := range strings.Split(v, ",") {
+		t = strings.TrimSpace(t)
+		if len(t) == 0 {
+			continue
+		}
+		if _, _, _, err := splitTaint(t); err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func splitTaint(t string) (key, value, effect string, err error) {
+	kv, effect, ok := strings.Cut(t, ":")
+	if !ok || !slices.Contains(taintEffects, effect) {
+		return "", "", "", fmt.Errorf("taint %q must be in the form key=value:Effect with the effect one of %s", t, strings.Join(taintEffects, ", "))
+	}
+	key, value, _ = strings.Cut(kv, "=")
+	if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+		return "", "", "", fmt.Errorf("taint key %q is invalid: %s", key, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+		return "", "", "", fmt.Errorf("taint value %q is invalid: %s", value, strings.Join(errs, "; "))
+	}
+	return key, value, effect, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidateDNS1123Name(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		ok   bool
+	}{
+		{"demo", true},
+		{"demo-1", true},
+		{"1demo", true},
+		{"a", true},
+		{strings.Repeat("a", maxClusterNameLength), true},
+		{strings.Repeat("a", maxClusterNameLength+1), false},
+		{"", false},
+		{"Demo", false},
+		{"-demo", false},
+		{"demo-", false},
+		{"demo_1", false},
+		{"demo.1", false},
+		{"demo 1", false},
+	} {
+		if err := validateDNS1123Name(tc.name); (err == nil) != tc.ok {
+			t.Errorf("validateDNS1123Name(%q) = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestOddQuorum(t *testing.T) {
+	for _, tc := range []struct {
+		v  int
+		ok bool
+	}{
+		{3, true},
+		{5, true},
+		{9, true},
+		{101, true},
+		{1, false},
+		{2, false},
+		{4, false},
+		{10, false},
+		{0, false},
+		{-3, false},
+	} {
+		if err := oddQuorum(3)(tc.v); (err == nil) != tc.ok {
+			t.Errorf("oddQuorum(3)(%d) = %v, want ok %v", tc.v, err, tc.ok)
+		}
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	for _, tc := range []struct {
+		v    string
+		want map[string]string
+		ok   bool
+	}{
+		{"", map[string]string{}, true},
+		{"env=prod", map[string]string{"env": "prod"}, true},
+		{" env=prod , tier=web ,", map[string]string{"env": "prod", "tier": "web"}, true},
+		{"ksctl.com/pool=gpu", map[string]string{"ksctl.com/pool": "gpu"}, true},
+		{"env=", map[string]string{"env": ""}, true},
+		{"env=prod,env=dev", map[string]string{"env": "dev"}, true},
+		{"env", nil, false},
+		{"=prod", nil, false},
+		{"env=prod value", nil, false},
+		{"-env=prod", nil, false},
+		{"env=" + strings.Repeat("a", 64), nil, false},
+	} {
+		got, err := parseLabels(tc.v)
+		if (err == nil) != tc.ok || !maps.Equal(got, tc.want) {
+			t.Errorf("parseLabels(%q) = %v, %v, want %v, ok %v", tc.v, got, err, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseTaints(t *testing.T) {
+	for _, tc := range []struct {
+		v    string
+		want []string
+		ok   bool
+	}{
+		{"", []string{}, true},
+		{"gpu=true:NoSchedule", []string{"gpu=true:NoSchedule"}, true},
+		{" gpu:NoExecute , spot=yes:PreferNoSchedule ", []string{"gpu:NoExecute", "spot=yes:PreferNoSchedule"}, true},
+		{"ksctl.com/dedicated=db:NoSchedule", []string{"ksctl.com/dedicated=db:NoSchedule"}, true},
+		{"gpu=true", nil, false},
+		{"gpu=true:Never", nil, false},
+		{"gpu=true:noschedule", nil, false},
+		{":NoSchedule", nil, false},
+		{"gpu=a b:NoSchedule", nil, false},
+		{"gpu:NoSchedule,bad", nil, false},
+	} {
+		got, err := parseTaints(tc.v)
+		if (err == nil) != tc.ok || !slices.Equal(got, tc.want) {
+			t.Errorf("parseTaints(%q) = %v, %v, want %v, ok %v", tc.v, got, err, tc.want, tc.ok)
+		}
+	}
+}
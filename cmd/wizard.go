@@ -0,0 +1,208 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/ksctl/cli/v2/pkg/cli"
+)
+
+// wizardStep is a question, or a computation on the previous answers, of the wizard
+type wizardStep struct {
+	name string
+	// title is the field of the blueprint answered by the step, the steps with a title can be edited in the review
+	title string
+	// dependsOn are the steps whose answers invalidate the answer of this step
+	dependsOn []string
+	// interactive steps prompt the user, going back skips the other ones
+	interactive bool
+	skip        func() bool
+	run         func() error
+	// resume restores what an answered step sets up besides its answer, when the wizard is resumed
+	resume func() error
+	// auto answers the step without prompting, it is set by a preset till the user edits the step
+	auto func() error
+}
+
+func (s wizardStep) skipped() bool {
+	return s.skip != nil && s.skip()
+}
+
+// isBack reports whether the user asked to go back from one of the prompts
+func isBack(err error) bool {
+	return errors.Is(err, cli.ErrBack)
+}
+
+type wizard struct {
+	k     *KsctlCommand
+	steps []wizardStep
+	// answered are the interactive steps which are not asked again when the wizard is run
+	answered map[string]bool
+	// onAnswered is called after every step
+	onAnswered func()
+	// canProceed is checked when the user proceeds from the review, the review is shown again when it returns false
+	canProceed func() (bool, error)
+}
+
+// runSequence runs the steps in order, going back re-asks the previous interactive step,
+// it returns false when the user went back from the first one.
+// The answered steps are only asked again when reask is set
+func (w *wizard) runSequence(seq []wizardStep, reask bool) (bool, error) {
+	m := w.k.menuDriven
+	w.k.menuDriven = cli.WithBackNavigation(m)
+	defer func() { w.k.menuDriven = m }()
+
+	for i := 0; i < len(seq); {
+		s := seq[i]
+		if s.skipped() {
+			i++
+			continue
+		}
+		if !reask && s.interactive && w.answered[s.name] {
+			if s.resume != nil {
+				if err := s.resume(); err != nil {
+					return false, err
+				}
+			}
+			i++
+			continue
+		}
+
+		run := s.run
+		if s.auto != nil {
+			run = s.auto
+		}
+		err := run()
+		if err == nil {
+			w.answered[s.name] = true
+			if w.onAnswered != nil {
+				w.onAnswered()
+			}
+			i++
+			continue
+		}
+		if !isBack(err) {
+			return false, err
+		}
+		w.k.l.Debug(w.k.Ctx, "Going back", "from", s.name)
+
+		prev := -1
+		for j := i - 1; j >= 0; j-- {
+			if seq[j].interactive && seq[j].auto == nil && !seq[j].skipped() {
+				prev = j
+				break
+			}
+		}
+		if prev < 0 {
+			return false, nil
+		}
+		delete(w.answered, seq[prev].name)
+		i = prev
+	}
+	return true, nil
+}
+
+// run asks every step which is not answered yet, the first one can't be left by going back
+func (w *wizard) run() error {
+	for {
+		if done, err := w.runSequence(w.steps, false); err != nil || done {
+			return err
+		}
+	}
+}
+
+// dependents returns the step and the ones depending on it directly or transitively
+func (w *wizard) dependents(name string) []wizardStep {
+	changed := map[string]bool{name: true}
+	res := []wizardStep{}
+	for _, s := range w.steps {
+		if !changed[s.name] && !slices.ContainsFunc(s.dependsOn, func(d string) bool { return changed[d] }) {
+			continue
+		}
+		changed[s.name] = true
+		res = append(res, s)
+	}
+	return res
+}
+
+// edit re-asks the step and recomputes the steps depending on it,
+// going back from the step returns to the review without any change
+func (w *wizard) edit(name string) error {
+	for i := range w.steps {
+		if w.steps[i].name == name {
+			w.steps[i].auto = nil
+		}
+	}
+	_, err := w.runSequence(w.dependents(name), true)
+	return err
+}
+
+const (
+	reviewProceed = "proceed"
+	reviewAbort   = "abort"
+)
+
+var errWizardAborted = errors.New("aborted by the user")
+
+// review renders the blueprint and lets the user edit any step with a title till they proceed
+func (w *wizard) review(render func() error, proceedLabel string) error {
+	for {
+		if err := render(); err != nil {
+			return err
+		}
+
+		options := map[string]string{
+			proceedLabel: reviewProceed,
+			"Abort":      reviewAbort,
+		}
+		for _, s := range w.steps {
+			if len(s.title) != 0 && !s.skipped() {
+				options["Edit "+s.title] = s.name
+			}
+		}
+
+		v, err := w.k.menuDriven.DropDown("Review the blueprint", options, cli.WithDefaultValue(reviewProceed))
+		if err != nil {
+			return err
+		}
+
+		switch v {
+		case reviewProceed:
+			if w.canProceed == nil {
+				return nil
+			}
+			ok, err := w.canProceed()
+			if err != nil || ok {
+				return err
+			}
+		case reviewAbort:
+			return errWizardAborted
+		default:
+			if err := w.edit(v); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// runWithReview asks the unanswered steps and then the review of the blueprint
+func (w *wizard) runWithReview(render func() error, proceedLabel string) error {
+	if err := w.run(); err != nil {
+		return err
+	}
+	return w.review(render, proceedLabel)
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+// backNavigation shows every prompt WithBackOption, the prompts return ErrBack when the
+// user wants to go back and the callers have to pass it up to the wizard
+type backNavigation struct {
+	MenuDriven
+}
+
+// WithBackNavigation wraps the menu for a wizard
+func WithBackNavigation(m MenuDriven) MenuDriven {
+	if b, ok := m.(backNavigation); ok {
+		return b
+	}
+	return backNavigation{m}
+}
+
+// WithoutBackNavigation returns the menu wrapped by WithBackNavigation
+func WithoutBackNavigation(m MenuDriven) MenuDriven {
+	if b, ok := m.(backNavigation); ok {
+		return b.MenuDriven
+	}
+	return m
+}
+
+func (b backNavigation) Confirmation(prompt string, opts ...func(*option) error) (bool, error) {
+	return b.MenuDriven.Confirmation(prompt, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) TextInput(prompt string, opts ...func(*option) error) (string, error) {
+	return b.MenuDriven.TextInput(prompt, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) TextInputPassword(prompt string, opts ...func(*option) error) (string, error) {
+	return b.MenuDriven.TextInputPassword(prompt, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) DropDown(prompt string, options map[string]string, opts ...func(*option) error) (string, error) {
+	return b.MenuDriven.DropDown(prompt, options, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) DropDownList(prompt string, options []string, opts ...func(*option) error) (string, error) {
+	return b.MenuDriven.DropDownList(prompt, options, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) MultiSelect(prompt string, options map[string]string, opts ...func(*option) error) ([]string, error) {
+	return b.MenuDriven.MultiSelect(prompt, options, append(opts, WithBackOption())...)
+}
+
+func (b backNavigation) CardSelection(element CardPack, opts ...func(*option) error) (string, error) {
+	return b.MenuDriven.CardSelection(element, append(opts, WithBackOption())...)
+}
@@ -22,12 +22,25 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
 )
 
 // BlueprintUI is responsible for rendering the cluster blueprint with enhanced UI
 type BlueprintUI struct {
-	writer io.Writer
+	writer       io.Writer
+	monthlyCost  float64
+	costCurrency string
+	workerPools  []BlueprintWorkerPool
+}
+
+// BlueprintWorkerPool is a worker pool shown in place of the worker nodes
+type BlueprintWorkerPool struct {
+	Name         string
+	InstanceType string
+	Count        int
+	// Scheduling is the labels and taints of the nodes
+	Scheduling string
 }
 
 // NewBlueprintUI creates a new instance of BlueprintUI
@@ -37,6 +50,19 @@ func NewBlueprintUI(w io.Writer) *BlueprintUI {
 	}
 }
 
+// WithMonthlyCost adds the estimated monthly cost to the blueprint
+func (ui *BlueprintUI) WithMonthlyCost(amount float64, code string) *BlueprintUI {
+	ui.monthlyCost = amount
+	ui.costCurrency = code
+	return ui
+}
+
+// WithWorkerPools shows the worker nodes split by their pools
+func (ui *BlueprintUI) WithWorkerPools(pools []BlueprintWorkerPool) *BlueprintUI {
+	ui.workerPools = pools
+	return ui
+}
+
 // RenderClusterBlueprint renders the cluster metadata with enhanced UI
 func (ui *BlueprintUI) RenderClusterBlueprint(meta controller.Metadata) {
 	parentBox := lipgloss.NewStyle().
@@ -105,7 +131,16 @@ func (ui *BlueprintUI) RenderClusterBlueprint(meta controller.Metadata) {
 			content.WriteString(keyValueRow("Control Plane", fmt.Sprintf("%d × %s", meta.NoCP, color.HiMagentaString(meta.ControlPlaneNodeType))))
 			content.WriteString("\n")
 		}
-		if meta.NoWP > 0 {
+		if len(ui.workerPools) > 0 {
+			for _, p := range ui.workerPools {
+				content.WriteString(keyValueRow("Worker Pool "+p.Name, fmt.Sprintf("%d × %s", p.Count, color.HiMagentaString(p.InstanceType))))
+				content.WriteString("\n")
+				if p.Scheduling != "" {
+					content.WriteString(keyValueRow("", color.HiBlackString(p.Scheduling)))
+					content.WriteString("\n")
+				}
+			}
+		} else if meta.NoWP > 0 {
 			content.WriteString(keyValueRow("Worker Nodes", fmt.Sprintf("%d × %s", meta.NoWP, color.HiMagentaString(meta.WorkerPlaneNodeType))))
 			content.WriteString("\n")
 		}
@@ -119,6 +154,10 @@ func (ui *BlueprintUI) RenderClusterBlueprint(meta controller.Metadata) {
 		}
 		if len(meta.ManagedNodeType) > 0 {
 			content.WriteString(keyValueRow("Managed Nodes", fmt.Sprintf("%d × %s", meta.NoMP, color.HiMagentaString(meta.ManagedNodeType))))
+			content.WriteString("\n")
+		}
+		if ui.monthlyCost > 0 {
+			content.WriteString(keyValueRow("Estimated Cost", color.HiGreenString("%s/month", currency.Format(ui.monthlyCost, ui.costCurrency))))
 		}
 
 		// Trim trailing newline if present
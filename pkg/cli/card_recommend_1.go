@@ -17,6 +17,7 @@ package cli
 import (
 	"fmt"
 	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/ksctl/v2/pkg/consts"
 	"github.com/ksctl/ksctl/v2/pkg/provider/optimizer"
 	"strings"
@@ -26,6 +27,7 @@ type cardRecommendation struct {
 	rr          optimizer.RegionRecommendation
 	oo          *optimizer.RecommendationAcrossRegions
 	clusterType consts.KsctlClusterType
+	currency    string
 }
 
 const (
@@ -44,7 +46,7 @@ func (c cardRecommendation) GetUpper() string {
 	priceDrop := (c.oo.CurrentTotalCost - c.rr.TotalCost) / c.oo.CurrentTotalCost * 100
 	priceStr.WriteString(fmt.Sprintf(
 		"Price: %s %s\n",
-		color.MagentaString(fmt.Sprintf("$%.2f", c.rr.TotalCost)),
+		color.MagentaString(currency.Format(c.rr.TotalCost, c.currency)),
 		color.HiGreenString(fmt.Sprintf("↓ %.0f%%", priceDrop)),
 	))
 
@@ -147,6 +149,7 @@ func (c cardRecommendation) GetLower() string {
 
 type cardRecommendations struct {
 	mm         consts.KsctlClusterType
+	currency   string
 	oo         *optimizer.RecommendationAcrossRegions
 	tt         []cardRecommendation
 	lenOfItems int
@@ -162,7 +165,7 @@ func (c cardRecommendations) GetItem(i int) CardItem {
 
 func (c cardRecommendations) GetInstruction() string {
 	instructions := "← → to navigate • enter to select plan • q to skip changing region"
-	instructions += " • Currently it costs " + fmt.Sprintf("`$%.2f`", c.oo.CurrentTotalCost) + " in " + color.HiCyanString(c.oo.CurrentRegion.Name)
+	instructions += " • Currently it costs " + fmt.Sprintf("`%s`", currency.Format(c.oo.CurrentTotalCost, c.currency)) + " in " + color.HiCyanString(c.oo.CurrentRegion.Name)
 
 	return instructions
 }
@@ -175,10 +178,11 @@ func (c cardRecommendations) GetCardConfiguration() (cardWidth, noOfVisibleItems
 	return 45, 2
 }
 
-func ConverterForRecommendationIOutputForCards(oo *optimizer.RecommendationAcrossRegions, tt consts.KsctlClusterType) CardPack {
+func ConverterForRecommendationIOutputForCards(oo *optimizer.RecommendationAcrossRegions, tt consts.KsctlClusterType, currencyCode string) CardPack {
 	res := new(cardRecommendations)
 	res.oo = oo
 	res.mm = tt
+	res.currency = currencyCode
 	res.lenOfItems = len(oo.RegionRecommendations)
 
 	for i, _ := range oo.RegionRecommendations {
@@ -186,8 +190,18 @@ func ConverterForRecommendationIOutputForCards(oo *optimizer.RecommendationAcros
 			oo.RegionRecommendations[i],
 			oo,
 			tt,
+			currencyCode,
 		})
 	}
 
 	return res
 }
+
+func (c cardRecommendations) GetAttributes(i int) CardAttributes {
+	r := c.oo.RegionRecommendations[i]
+	attrs := regionAttributes(r.Region)
+	attrs.Values[CardAttrPrice] = r.TotalCost
+	attrs.Currency = c.currency
+	attrs.LatencyNotes = fmt.Sprintf("moves from %s, check the latency to your users", c.oo.CurrentRegion.Name)
+	return attrs
+}
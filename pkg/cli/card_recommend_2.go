@@ -0,0 +1,129 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+type cardInstanceRecommendation struct {
+	rr      provider.InstanceRegionOutput
+	current provider.InstanceRegionOutput
+}
+
+func (c cardInstanceRecommendation) GetUpper() string {
+	resp := strings.Builder{}
+
+	price := color.MagentaString(currency.Format(c.rr.GetCost(), c.rr.Price.Currency))
+	if curr := c.current.GetCost(); curr > 0 {
+		change := (c.rr.GetCost() - curr) / curr * 100
+		if change < 0 {
+			price += color.HiGreenString(" ↓ %.0f%%", -change)
+		} else if change > 0 {
+			price += color.HiRedString(" ↑ %.0f%%", change)
+		}
+	}
+	resp.WriteString(fmt.Sprintf("Price: %s\n", price))
+
+	if c.rr.EmboddedEmissions != nil {
+		emission := fmt.Sprintf("%.2f %s", c.rr.EmboddedEmissions.EmboddedCo2, c.rr.EmboddedEmissions.Co2Unit)
+		if e := c.current.EmboddedEmissions; e != nil && e.EmboddedCo2 > 0 {
+			change := (c.rr.EmboddedEmissions.EmboddedCo2 - e.EmboddedCo2) / e.EmboddedCo2 * 100
+			if change < 0 {
+				emission += color.HiGreenString(" ↓ %.0f%%", -change)
+			} else if change > 0 {
+				emission += color.HiRedString(" ↑ %.0f%%", change)
+			}
+		}
+		resp.WriteString(fmt.Sprintf("🏭 Embodied: %s\n", emission))
+	} else {
+		resp.WriteString(color.HiYellowString("Emissions data is currently unavailable 🌍\n"))
+	}
+
+	return resp.String()
+}
+
+func (c cardInstanceRecommendation) GetLower() string {
+	resp := strings.Builder{}
+
+	delta := func(v, curr int) string {
+		if v > curr {
+			return color.HiGreenString(" (+%d)", v-curr)
+		}
+		return ""
+	}
+
+	resp.WriteString(fmt.Sprintf("Code: %s\n", color.HiMagentaString(c.rr.Sku)))
+	resp.WriteString(fmt.Sprintf("vCPUs: %d%s\n", c.rr.VCpus, delta(c.rr.VCpus, c.current.VCpus)))
+	resp.WriteString(fmt.Sprintf("Memory: %d GB%s\n", c.rr.Memory, delta(c.rr.Memory, c.current.Memory)))
+	resp.WriteString(fmt.Sprintf("Category: %s", c.rr.Category))
+
+	return resp.String()
+}
+
+type cardInstanceRecommendations struct {
+	role       string
+	current    provider.InstanceRegionOutput
+	rr         provider.InstancesRegionOutput
+	tt         []cardInstanceRecommendation
+	lenOfItems int
+}
+
+func (c cardInstanceRecommendations) LenOfItems() int {
+	return c.lenOfItems
+}
+
+func (c cardInstanceRecommendations) GetItem(i int) CardItem {
+	return c.tt[i]
+}
+
+func (c cardInstanceRecommendations) GetInstruction() string {
+	instructions := "← → to navigate • enter to switch the instance type • q to keep the current one"
+	instructions += " • " + color.HiCyanString(c.role) + " uses " + color.HiMagentaString(c.current.Sku) +
+		fmt.Sprintf(" (`%s`)", currency.Format(c.current.GetCost(), c.current.Price.Currency))
+
+	return instructions
+}
+
+func (c cardInstanceRecommendations) GetResult(i int) string {
+	return c.rr[i].Sku
+}
+
+func (c cardInstanceRecommendations) GetCardConfiguration() (cardWidth, noOfVisibleItems int) {
+	return 36, 3
+}
+
+func ConverterForInstanceRecommendationsForCards(role string, current provider.InstanceRegionOutput, alternatives provider.InstancesRegionOutput) CardPack {
+	res := new(cardInstanceRecommendations)
+	res.role = role
+	res.current = current
+	res.rr = alternatives
+	res.lenOfItems = len(alternatives)
+
+	for i := range alternatives {
+		res.tt = append(res.tt, cardInstanceRecommendation{alternatives[i], current})
+	}
+
+	return res
+}
+
+func (c cardInstanceRecommendations) GetAttributes(i int) CardAttributes {
+	return instanceAttributes(c.rr[i])
+}
@@ -116,3 +116,21 @@ func ConverterForRegionOutputForCards(regions provider.RegionsOutput) CardPack {
 
 	return res
 }
+
+func regionAttributes(r provider.RegionOutput) CardAttributes {
+	attrs := CardAttributes{
+		SearchText:   r.Sku + " " + r.Name,
+		Values:       map[CardAttribute]float64{},
+		LatencyNotes: "depends on the distance to your users",
+	}
+	if r.Emission != nil {
+		attrs.Values[CardAttrEmissions] = r.Emission.DirectCarbonIntensity
+		attrs.Values[CardAttrCarbonIntensity] = r.Emission.DirectCarbonIntensity
+		attrs.Values[CardAttrRenewable] = r.Emission.RenewablePercentage
+	}
+	return attrs
+}
+
+func (c cardRegions) GetAttributes(i int) CardAttributes {
+	return regionAttributes(c.rr[i])
+}
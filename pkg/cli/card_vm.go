@@ -17,6 +17,7 @@ package cli
 import (
 	"fmt"
 	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/currency"
 	"github.com/ksctl/ksctl/v2/pkg/provider"
 	"strings"
 )
@@ -28,9 +29,13 @@ type cardVM struct {
 func (c cardVM) GetUpper() string {
 	resp := strings.Builder{}
 
+	if c.rr.CpuArch == provider.ArchArm64 {
+		resp.WriteString(color.New(color.BgHiCyan, color.FgBlack, color.Bold).Sprint(" ARM64 ") + "\n")
+	}
+
 	resp.WriteString(fmt.Sprintf(
 		"Price: %s\n",
-		color.HiMagentaString("%s %.2f", c.rr.Price.Currency, c.rr.GetCost()),
+		color.HiMagentaString(currency.Format(c.rr.GetCost(), c.rr.Price.Currency)),
 	))
 	if c.rr.EmboddedEmissions != nil {
 		resp.WriteString(fmt.Sprintf("🏭 Embodied: %.2f %s\n", c.rr.EmboddedEmissions.EmboddedCo2, c.rr.EmboddedEmissions.Co2Unit))
@@ -111,3 +116,24 @@ func ConverterForInstanceTypesForCards(vms provider.InstancesRegionOutput) CardP
 
 	return res
 }
+
+func instanceAttributes(vm provider.InstanceRegionOutput) CardAttributes {
+	attrs := CardAttributes{
+		SearchText: strings.Join([]string{vm.Sku, vm.Description, string(vm.CpuArch), string(vm.Category)}, " "),
+		Values: map[CardAttribute]float64{
+			CardAttrVCpus:  float64(vm.VCpus),
+			CardAttrMemory: float64(vm.Memory),
+			CardAttrPrice:  vm.GetCost(),
+		},
+		Currency:     vm.Price.Currency,
+		LatencyNotes: "same region",
+	}
+	if vm.EmboddedEmissions != nil {
+		attrs.Values[CardAttrEmissions] = vm.EmboddedEmissions.EmboddedCo2
+	}
+	return attrs
+}
+
+func (c cardVMs) GetAttributes(i int) CardAttributes {
+	return instanceAttributes(c.rr[i])
+}
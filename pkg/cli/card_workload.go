@@ -0,0 +1,123 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/ksctl/cli/v2/pkg/currency"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+// WorkloadRecommendation is a node pool sized for the workload
+type WorkloadRecommendation struct {
+	Instance provider.InstanceRegionOutput
+	Count    int
+	// CPUUtilization and MemoryUtilization are the percentage of the pool used by the workload
+	CPUUtilization    float64
+	MemoryUtilization float64
+	PodsPerNode       int
+}
+
+func (w WorkloadRecommendation) MonthlyCost() float64 {
+	return float64(w.Count) * w.Instance.GetCost()
+}
+
+type cardWorkload struct {
+	rr WorkloadRecommendation
+}
+
+func utilizationString(v float64) string {
+	s := fmt.Sprintf("%.0f%%", v)
+	if v > 75 {
+		return color.HiYellowString(s)
+	}
+	return color.HiGreenString(s)
+}
+
+func (c cardWorkload) GetUpper() string {
+	resp := strings.Builder{}
+
+	resp.WriteString(fmt.Sprintf(
+		"Price: %s\n",
+		color.HiMagentaString("%s/month", currency.Format(c.rr.MonthlyCost(), c.rr.Instance.Price.Currency)),
+	))
+	resp.WriteString(fmt.Sprintf("Nodes: %s\n", color.HiCyanString("%d x %s", c.rr.Count, c.rr.Instance.Sku)))
+	resp.WriteString(fmt.Sprintf("CPU used: %s\n", utilizationString(c.rr.CPUUtilization)))
+	resp.WriteString(fmt.Sprintf("Memory used: %s\n", utilizationString(c.rr.MemoryUtilization)))
+
+	return resp.String()
+}
+
+func (c cardWorkload) GetLower() string {
+	resp := strings.Builder{}
+
+	resp.WriteString(fmt.Sprintf("vCPUs: %d\n", c.rr.Instance.VCpus))
+	resp.WriteString(fmt.Sprintf("Memory: %d GB\n", c.rr.Instance.Memory))
+	resp.WriteString(fmt.Sprintf("Category: %s\n", c.rr.Instance.Category))
+	resp.WriteString(fmt.Sprintf("Pods/node: ~%d", c.rr.PodsPerNode))
+
+	return resp.String()
+}
+
+type cardWorkloads struct {
+	rr         []WorkloadRecommendation
+	tt         []cardWorkload
+	lenOfItems int
+}
+
+func (c cardWorkloads) LenOfItems() int {
+	return c.lenOfItems
+}
+
+func (c cardWorkloads) GetItem(i int) CardItem {
+	return c.tt[i]
+}
+
+func (c cardWorkloads) GetInstruction() string {
+	return "← → to navigate • enter to select the node pool • q to pick the instance type manually"
+}
+
+func (c cardWorkloads) GetResult(i int) string {
+	return c.rr[i].Instance.Sku
+}
+
+func (c cardWorkloads) GetCardConfiguration() (cardWidth, noOfVisibleItems int) {
+	return 36, 3
+}
+
+func ConverterForWorkloadRecommendationsForCards(recs []WorkloadRecommendation) CardPack {
+	res := new(cardWorkloads)
+	res.lenOfItems = len(recs)
+	res.rr = recs
+
+	for i := range recs {
+		res.tt = append(res.tt, cardWorkload{recs[i]})
+	}
+
+	return res
+}
+
+func (c cardWorkloads) GetAttributes(i int) CardAttributes {
+	r := c.rr[i]
+	attrs := instanceAttributes(r.Instance)
+	attrs.Values[CardAttrPrice] = r.MonthlyCost()
+	if v, ok := attrs.Values[CardAttrEmissions]; ok {
+		attrs.Values[CardAttrEmissions] = v * float64(r.Count)
+	}
+	return attrs
+}
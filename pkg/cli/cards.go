@@ -20,11 +20,21 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"slices"
+	"strconv"
 	"strings"
 )
 
-func (p *genericMenuDriven) CardSelection(pack CardPack) (string, error) {
-	t := tea.NewProgram(newCardRunner(pack))
+func (p *genericMenuDriven) CardSelection(pack CardPack, opts ...func(*option) error) (string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
+	runner := newCardRunner(pack)
+	runner.keys.back.SetEnabled(o.back)
+
+	t := tea.NewProgram(runner)
 	finalModel, err := t.Run()
 	if err != nil {
 		return "", err
@@ -35,6 +45,10 @@ func (p *genericMenuDriven) CardSelection(pack CardPack) (string, error) {
 		return "", fmt.Errorf("failed to cast final model to cardRunner type")
 	}
 
+	if m.wentBack {
+		return "", ErrBack
+	}
+
 	if m.selectedPlan >= 0 && m.selectedPlan < pack.LenOfItems() {
 		plan := pack.GetResult(m.selectedPlan)
 		return plan, nil
@@ -46,6 +60,14 @@ func (p *genericMenuDriven) CardSelection(pack CardPack) (string, error) {
 	return "", fmt.Errorf("internal problem. invalid selected plan index: %d", m.selectedPlan)
 }
 
+type cardInputMode int
+
+const (
+	cardInputNone cardInputMode = iota
+	cardInputSearch
+	cardInputFilter
+)
+
 type cardRunner struct {
 	b            CardPack
 	currentPlan  int
@@ -54,30 +76,61 @@ type cardRunner struct {
 	help         help.Model
 	keys         keyMap
 	quitting     bool
+	wentBack     bool
 	selectedPlan int // -1 means no selection yet
+
+	// view holds the indexes of the pack which are displayed, currentPlan is a position in it
+	view      []int
+	query     cardQuery
+	inputMode cardInputMode
+	input     string
+	filter    cardFilter
+
+	// marked holds the indexes of the pack tagged for the comparison
+	marked        []int
+	comparing     bool
+	compareCursor int
 }
 
 type keyMap struct {
 	left     key.Binding
 	right    key.Binding
+	pageUp   key.Binding
+	pageDown key.Binding
+	first    key.Binding
+	last     key.Binding
 	selected key.Binding
+	search   key.Binding
+	filters  []key.Binding
+	sort     key.Binding
+	reverse  key.Binding
+	clear    key.Binding
+	mark     key.Binding
+	compare  key.Binding
+	back     key.Binding
 	quit     key.Binding
 	help     key.Binding
 }
 
 func (k keyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.help, k.quit}
+	return []key.Binding{k.search, k.sort, k.mark, k.compare, k.back, k.help, k.quit}
 }
 
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.left, k.right, k.selected},
-		{k.help, k.quit},
+		{k.left, k.right, k.pageUp, k.pageDown, k.first, k.last},
+		{k.search, k.sort, k.reverse, k.clear},
+		k.filters,
+		{k.mark, k.compare},
+		{k.selected, k.back, k.help, k.quit},
 	}
 }
 
+// filterKeys are bound to the cardFilters in the same order
+var filterKeys = []string{"v", "m", "p", "i"}
+
 func newKeyMap() keyMap {
-	return keyMap{
+	k := keyMap{
 		left: key.NewBinding(
 			key.WithKeys("left", "h"),
 			key.WithHelp("←/h", "previous plan"),
@@ -86,10 +139,55 @@ func newKeyMap() keyMap {
 			key.WithKeys("right", "l"),
 			key.WithHelp("→/l", "next plan"),
 		),
+		pageUp: key.NewBinding(
+			key.WithKeys("pgup", "["),
+			key.WithHelp("pgup/[", "previous page"),
+		),
+		pageDown: key.NewBinding(
+			key.WithKeys("pgdown", "]"),
+			key.WithHelp("pgdn/]", "next page"),
+		),
+		first: key.NewBinding(
+			key.WithKeys("home", "g"),
+			key.WithHelp("home/g", "first plan"),
+		),
+		last: key.NewBinding(
+			key.WithKeys("end", "G"),
+			key.WithHelp("end/G", "last plan"),
+		),
 		selected: key.NewBinding(
 			key.WithKeys("enter", " "),
 			key.WithHelp("enter/space", "select plan"),
 		),
+		search: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "search"),
+		),
+		sort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "change sort"),
+		),
+		reverse: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reverse sort"),
+		),
+		clear: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "clear search & filters"),
+		),
+		mark: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "mark to compare"),
+		),
+		compare: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", fmt.Sprintf("compare %d-%d marked", minComparedCards, maxComparedCards)),
+		),
+		back: key.NewBinding(
+			key.WithKeys("b"),
+			key.WithHelp("b", "go back"),
+			key.WithDisabled(),
+		),
 		help: key.NewBinding(
 			key.WithKeys("?"),
 			key.WithHelp("?", "toggle help"),
@@ -99,30 +197,181 @@ func newKeyMap() keyMap {
 			key.WithHelp("q/esc", "quit"),
 		),
 	}
+	for i, f := range cardFilters {
+		k.filters = append(k.filters, key.NewBinding(
+			key.WithKeys(filterKeys[i]),
+			key.WithHelp(filterKeys[i], f.label),
+		))
+	}
+	return k
 }
 
 func newCardRunner(b CardPack) cardRunner {
-	return cardRunner{
+	keys := newKeyMap()
+
+	// only the packs which expose the attributes of their cards can be searched, filtered and sorted
+	ap, ok := b.(AttributedCardPack)
+	keys.search.SetEnabled(ok)
+	keys.sort.SetEnabled(ok)
+	keys.reverse.SetEnabled(ok)
+	keys.clear.SetEnabled(ok)
+	var attrs map[CardAttribute]bool
+	if ok {
+		attrs = availableAttributes(ap)
+	}
+	for i, f := range cardFilters {
+		keys.filters[i].SetEnabled(attrs[f.attr])
+	}
+
+	m := cardRunner{
 		b:            b,
 		currentPlan:  0,
 		help:         help.New(),
-		keys:         newKeyMap(),
+		keys:         keys,
 		selectedPlan: -1,
+		query:        cardQuery{filters: map[CardAttribute]float64{}},
 	}
+	m.view = m.query.apply(b)
+	return m
 }
 
 func (m cardRunner) Init() tea.Cmd {
 	return nil
 }
 
+// refresh reapplies the query and keeps the current card selected when it is still displayed
+func (m *cardRunner) refresh() {
+	current := -1
+	if m.currentPlan < len(m.view) {
+		current = m.view[m.currentPlan]
+	}
+
+	m.view = m.query.apply(m.b)
+	m.currentPlan = 0
+	for i, v := range m.view {
+		if v == current {
+			m.currentPlan = i
+			break
+		}
+	}
+}
+
+func (m *cardRunner) moveTo(pos int) {
+	m.currentPlan = max(0, min(pos, len(m.view)-1))
+}
+
+func (m cardRunner) pageSize() int {
+	_, visible := m.b.GetCardConfiguration()
+	return max(1, visible)
+}
+
+func (m cardRunner) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		m.quitting = true
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		if m.inputMode == cardInputSearch {
+			m.query.search = ""
+			m.refresh()
+		}
+		m.inputMode = cardInputNone
+		m.input = ""
+		return m, nil
+
+	case tea.KeyEnter:
+		if m.inputMode == cardInputFilter {
+			v := strings.TrimSpace(m.input)
+			if len(v) == 0 {
+				delete(m.query.filters, m.filter.attr)
+			} else if f, err := strconv.ParseFloat(v, 64); err == nil {
+				m.query.filters[m.filter.attr] = f
+			} else {
+				// keep the prompt open till a valid number is entered
+				return m, nil
+			}
+			m.refresh()
+		}
+		m.inputMode = cardInputNone
+		m.input = ""
+		return m, nil
+
+	case tea.KeyBackspace:
+		if r := []rune(m.input); len(r) > 0 {
+			m.input = string(r[:len(r)-1])
+		}
+
+	case tea.KeySpace:
+		m.input += " "
+
+	case tea.KeyRunes:
+		m.input += string(msg.Runes)
+
+	default:
+		return m, nil
+	}
+
+	if m.inputMode == cardInputSearch {
+		m.query.search = m.input
+		m.refresh()
+	}
+	return m, nil
+}
+
+func (m cardRunner) updateCompare(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Type == tea.KeyCtrlC:
+		m.quitting = true
+		return m, tea.Quit
+
+	case key.Matches(msg, m.keys.compare), msg.Type == tea.KeyEsc:
+		m.comparing = false
+
+	case key.Matches(msg, m.keys.left):
+		if m.compareCursor > 0 {
+			m.compareCursor--
+		}
+
+	case key.Matches(msg, m.keys.right):
+		if m.compareCursor < len(m.marked)-1 {
+			m.compareCursor++
+		}
+
+	case key.Matches(msg, m.keys.selected):
+		m.selectedPlan = m.marked[m.compareCursor]
+		return m, tea.Quit
+
+	case key.Matches(msg, m.keys.help):
+		m.help.ShowAll = !m.help.ShowAll
+	}
+	return m, nil
+}
+
+func (m cardRunner) isMarked(idx int) bool {
+	return slices.Contains(m.marked, idx)
+}
+
 func (m cardRunner) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.inputMode != cardInputNone {
+			return m.updateInput(msg)
+		}
+		if m.comparing {
+			return m.updateCompare(msg)
+		}
+
 		switch {
 		case key.Matches(msg, m.keys.quit):
 			m.quitting = true
 			return m, tea.Quit
 
+		case key.Matches(msg, m.keys.back):
+			m.quitting = true
+			m.wentBack = true
+			return m, tea.Quit
+
 		case key.Matches(msg, m.keys.help):
 			m.help.ShowAll = !m.help.ShowAll
 			return m, nil
@@ -136,20 +385,92 @@ func (m cardRunner) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, m.keys.right):
 			// Move to next plan
-			if m.currentPlan < m.b.LenOfItems()-1 {
+			if m.currentPlan < len(m.view)-1 {
 				m.currentPlan++
 			}
 			return m, nil
 
+		case key.Matches(msg, m.keys.pageUp):
+			m.moveTo(m.currentPlan - m.pageSize())
+			return m, nil
+
+		case key.Matches(msg, m.keys.pageDown):
+			m.moveTo(m.currentPlan + m.pageSize())
+			return m, nil
+
+		case key.Matches(msg, m.keys.first):
+			m.moveTo(0)
+			return m, nil
+
+		case key.Matches(msg, m.keys.last):
+			m.moveTo(len(m.view) - 1)
+			return m, nil
+
+		case key.Matches(msg, m.keys.search):
+			m.inputMode = cardInputSearch
+			m.input = m.query.search
+			return m, nil
+
+		case key.Matches(msg, m.keys.sort):
+			i := slices.Index(cardSortKeys, m.query.sortBy)
+			m.query.sortBy = cardSortKeys[(i+1)%len(cardSortKeys)]
+			m.refresh()
+			return m, nil
+
+		case key.Matches(msg, m.keys.reverse):
+			m.query.desc = !m.query.desc
+			m.refresh()
+			return m, nil
+
+		case key.Matches(msg, m.keys.clear):
+			m.query = cardQuery{filters: map[CardAttribute]float64{}}
+			m.refresh()
+			return m, nil
+
+		case key.Matches(msg, m.keys.mark):
+			if m.currentPlan >= len(m.view) {
+				return m, nil
+			}
+			idx := m.view[m.currentPlan]
+			if i := slices.Index(m.marked, idx); i >= 0 {
+				m.marked = slices.Delete(m.marked, i, i+1)
+			} else if len(m.marked) < maxComparedCards {
+				m.marked = append(m.marked, idx)
+			}
+			return m, nil
+
+		case key.Matches(msg, m.keys.compare):
+			if len(m.marked) >= minComparedCards {
+				m.comparing = true
+				m.compareCursor = 0
+			}
+			return m, nil
+
 		case key.Matches(msg, m.keys.selected):
 			// Select current plan
-			m.selectedPlan = m.currentPlan
-			return m, tea.Quit
+			if m.currentPlan < len(m.view) {
+				m.selectedPlan = m.view[m.currentPlan]
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+
+		for i, f := range m.keys.filters {
+			if key.Matches(msg, f) {
+				m.inputMode = cardInputFilter
+				m.filter = cardFilters[i]
+				m.input = ""
+				if v, ok := m.query.filters[m.filter.attr]; ok {
+					m.input = strconv.FormatFloat(v, 'f', -1, 64)
+				}
+				return m, nil
+			}
 		}
 
 	case tea.WindowSizeMsg:
 		m.windowWidth = msg.Width
 		m.windowHeight = msg.Height
+		m.help.Width = msg.Width
 		return m, nil
 	}
 
@@ -169,6 +490,10 @@ func (m cardRunner) View() string {
 		maxWidth = 100 // Default width
 	}
 
+	if m.comparing {
+		return m.compareView(maxWidth)
+	}
+
 	cardWidth, visibleCards := m.b.GetCardConfiguration()
 
 	// Add some top padding
@@ -178,7 +503,7 @@ func (m cardRunner) View() string {
 	// Determine which cards to show
 	var startIdx int
 
-	implLen := m.b.LenOfItems()
+	implLen := len(m.view)
 	if m.currentPlan == 0 {
 		startIdx = 0
 	} else if m.currentPlan == implLen-1 {
@@ -191,8 +516,9 @@ func (m cardRunner) View() string {
 	cards := make([]string, endIdx-startIdx)
 
 	for i := startIdx; i < endIdx; i++ {
-		plan := m.b.GetItem(i)
+		plan := m.b.GetItem(m.view[i])
 		isActive := i == m.currentPlan
+		isMarked := m.isMarked(m.view[i])
 
 		var borderColor, textColor, separatorColor lipgloss.Color
 		var borderStyle lipgloss.Border
@@ -208,6 +534,10 @@ func (m cardRunner) View() string {
 			separatorColor = lipgloss.Color("#555555")
 			borderStyle = lipgloss.RoundedBorder()
 		}
+		if isMarked {
+			borderColor = lipgloss.Color("13")
+			borderStyle = lipgloss.DoubleBorder()
+		}
 
 		priceStyle := lipgloss.NewStyle().
 			Foreground(textColor).
@@ -219,7 +549,11 @@ func (m cardRunner) View() string {
 			priceStyle = priceStyle.Bold(true)
 		}
 
-		priceSection := priceStyle.Render(plan.GetUpper())
+		upper := plan.GetUpper()
+		if isMarked {
+			upper = lipgloss.NewStyle().Foreground(lipgloss.Color("13")).Bold(true).Render("◆ marked") + "\n" + upper
+		}
+		priceSection := priceStyle.Render(upper)
 
 		separator := lipgloss.NewStyle().
 			Foreground(separatorColor).
@@ -258,7 +592,13 @@ func (m cardRunner) View() string {
 
 	var rowContent strings.Builder
 
-	rowContent.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, cards...))
+	if implLen == 0 {
+		rowContent.WriteString(lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#AAAAAA")).
+			Render("No plans match the search and filters"))
+	} else {
+		rowContent.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, cards...))
+	}
 
 	centeredStyle := lipgloss.NewStyle().Width(maxWidth).Align(lipgloss.Center)
 	builder.WriteString(centeredStyle.Render(rowContent.String()))
@@ -271,5 +611,36 @@ func (m cardRunner) View() string {
 
 	builder.WriteString(instructionStyle.Render(m.b.GetInstruction() + "\n\n"))
 
+	statusStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("10")).
+		Align(lipgloss.Center).
+		Width(maxWidth)
+
+	switch m.inputMode {
+	case cardInputSearch:
+		builder.WriteString(statusStyle.Render("/" + m.input + "█  (enter to apply • esc to cancel)"))
+		builder.WriteString("\n")
+	case cardInputFilter:
+		builder.WriteString(statusStyle.Render(m.filter.label + ": " + m.input + "█  (empty to remove • enter to apply • esc to cancel)"))
+		builder.WriteString("\n")
+	}
+
+	if len(m.marked) != 0 {
+		builder.WriteString(instructionStyle.Render(
+			fmt.Sprintf("marked %d of %d to compare", len(m.marked), maxComparedCards),
+		))
+		builder.WriteString("\n")
+	}
+
+	if !m.query.isEmpty() {
+		builder.WriteString(instructionStyle.Render(
+			fmt.Sprintf("%s • showing %d of %d", m.query.String(), implLen, m.b.LenOfItems()),
+		))
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString(instructionStyle.Render(m.help.View(m.keys)))
+	builder.WriteString("\n")
+
 	return builder.String()
 }
@@ -0,0 +1,237 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/ksctl/cli/v2/pkg/currency"
+)
+
+const (
+	minComparedCards = 2
+	maxComparedCards = 4
+)
+
+type compareRow struct {
+	attr CardAttribute
+	// higherIsBetter decides which value of the row is highlighted
+	higherIsBetter bool
+}
+
+var compareRows = []compareRow{
+	{attr: CardAttrPrice},
+	{attr: CardAttrVCpus, higherIsBetter: true},
+	{attr: CardAttrMemory, higherIsBetter: true},
+	{attr: CardAttrEmissions},
+	{attr: CardAttrCarbonIntensity},
+	{attr: CardAttrRenewable, higherIsBetter: true},
+}
+
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// cardFields extracts the "Label: value" lines of the card, it is used to compare
+// the cards of the packs which don't expose their attributes
+func cardFields(item CardItem) ([]string, map[string]string) {
+	labels := []string{}
+	values := map[string]string{}
+
+	text := ansiEscape.ReplaceAllString(item.GetUpper()+"\n"+item.GetLower(), "")
+	for _, line := range strings.Split(text, "\n") {
+		label, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		label, value = strings.TrimSpace(label), strings.TrimSpace(value)
+		if len(label) == 0 || len(value) == 0 {
+			continue
+		}
+		if _, ok := values[label]; !ok {
+			labels = append(labels, label)
+		}
+		values[label] = value
+	}
+	return labels, values
+}
+
+func formatAttribute(attr CardAttribute, v float64, currencyCode string) string {
+	switch {
+	case attr == CardAttrPrice && len(currencyCode) != 0:
+		return currency.Format(v, currencyCode)
+	case v == math.Trunc(v):
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+type compareCell struct {
+	value string
+	best  bool
+}
+
+// compareTable returns the rows of the comparison of the cards of the pack
+func compareTable(pack CardPack, cards []int) [][]compareCell {
+	res := [][]compareCell{}
+
+	ap, ok := pack.(AttributedCardPack)
+	if !ok {
+		labels := []string{}
+		fields := make([]map[string]string, len(cards))
+		for i, c := range cards {
+			l, v := cardFields(pack.GetItem(c))
+			for _, label := range l {
+				if !slices.Contains(labels, label) {
+					labels = append(labels, label)
+				}
+			}
+			fields[i] = v
+		}
+		for _, label := range labels {
+			row := []compareCell{{value: label}}
+			for i := range cards {
+				v, ok := fields[i][label]
+				if !ok {
+					v = "-"
+				}
+				row = append(row, compareCell{value: v})
+			}
+			res = append(res, row)
+		}
+		return res
+	}
+
+	attrs := make([]CardAttributes, len(cards))
+	for i, c := range cards {
+		attrs[i] = ap.GetAttributes(c)
+	}
+
+	for _, r := range compareRows {
+		row := []compareCell{{value: string(r.attr)}}
+		values := make([]float64, len(attrs))
+		known := make([]bool, len(attrs))
+		currencies := map[string]bool{}
+		best, noOfKnown := 0.0, 0
+		for i, a := range attrs {
+			v, ok := a.Values[r.attr]
+			if !ok {
+				row = append(row, compareCell{value: "-"})
+				continue
+			}
+			code := a.Currency
+			if r.attr == CardAttrPrice {
+				// prices are compared in the display currency, those without an exchange rate stay in their own
+				v, code = currency.Convert(v, a.Currency)
+				currencies[code] = true
+			}
+			if noOfKnown == 0 || (r.higherIsBetter && v > best) || (!r.higherIsBetter && v < best) {
+				best = v
+			}
+			values[i], known[i] = v, true
+			noOfKnown++
+			row = append(row, compareCell{value: formatAttribute(r.attr, v, code)})
+		}
+		if noOfKnown == 0 {
+			continue
+		}
+		// no winner among the prices in different currencies
+		if noOfKnown > 1 && len(currencies) <= 1 {
+			for i := range attrs {
+				if known[i] && values[i] == best {
+					row[i+1].best = true
+				}
+			}
+		}
+		res = append(res, row)
+	}
+
+	row := []compareCell{{value: "latency"}}
+	hasNotes := false
+	for _, a := range attrs {
+		v := a.LatencyNotes
+		if len(v) == 0 {
+			v = "-"
+		} else {
+			hasNotes = true
+		}
+		row = append(row, compareCell{value: v})
+	}
+	if hasNotes {
+		res = append(res, row)
+	}
+	return res
+}
+
+// compareView renders the marked cards side by side with the best value of every row highlighted
+func (m cardRunner) compareView(width int) string {
+	cells := compareTable(m.b, m.marked)
+
+	headers := []string{""}
+	for _, c := range m.marked {
+		headers = append(headers, m.b.GetResult(c))
+	}
+
+	rows := make([][]string, len(cells))
+	for i, r := range cells {
+		for _, c := range r {
+			rows[i] = append(rows[i], c.value)
+		}
+	}
+
+	baseStyle := lipgloss.NewStyle().Padding(0, 1)
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("#555555"))).
+		Headers(headers...).
+		Rows(rows...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			s := baseStyle.Foreground(lipgloss.Color("#AAAAAA"))
+			active := col == m.compareCursor+1
+			switch {
+			case row == table.HeaderRow:
+				s = s.Bold(true).Foreground(lipgloss.Color("#EEEEC7"))
+				if active {
+					s = s.Foreground(lipgloss.Color("10"))
+				}
+			case col == 0:
+				s = s.Foreground(lipgloss.Color("#888888"))
+			case cells[row][col].best:
+				s = s.Bold(true).Foreground(lipgloss.Color("10"))
+			case active:
+				s = s.Foreground(lipgloss.Color("#EEEEC7"))
+			}
+			return s
+		})
+
+	centered := lipgloss.NewStyle().Width(width).Align(lipgloss.Center)
+	instructionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#888888")).
+		Align(lipgloss.Center).
+		Width(width)
+
+	var builder strings.Builder
+	builder.WriteString("\n\n")
+	builder.WriteString(centered.Render(t.Render()))
+	builder.WriteString("\n\n")
+	builder.WriteString(instructionStyle.Render("best value of every row is highlighted • ← → to choose • enter to select • c/esc back to the cards"))
+	builder.WriteString("\n")
+	return builder.String()
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/currency"
+)
+
+func TestCompareTable(t *testing.T) {
+	price := func(v float64, code string) CardAttributes {
+		return CardAttributes{Values: map[CardAttribute]float64{CardAttrPrice: v}, Currency: code}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		pack      CardPack
+		cards     []int
+		converter *currency.Converter
+		want      [][]compareCell
+	}{
+		{
+			name: "best value of every row",
+			pack: testPack{attrs: []CardAttributes{
+				{Values: map[CardAttribute]float64{CardAttrPrice: 15, CardAttrVCpus: 2, CardAttrMemory: 2}, Currency: "USD"},
+				{Values: map[CardAttribute]float64{CardAttrPrice: 70, CardAttrVCpus: 2, CardAttrMemory: 8}, Currency: "USD"},
+			}},
+			cards: []int{0, 1},
+			want: [][]compareCell{
+				{{value: "price"}, {value: "$15.00", best: true}, {value: "$70.00"}},
+				{{value: "vCPU"}, {value: "2", best: true}, {value: "2", best: true}},
+				{{value: "memory"}, {value: "2"}, {value: "8", best: true}},
+			},
+		},
+		{
+			name: "unknown values are not ranked",
+			pack: testPack{attrs: []CardAttributes{
+				{Values: map[CardAttribute]float64{CardAttrRenewable: 40.5}},
+				{Values: map[CardAttribute]float64{}, LatencyNotes: "far from the users"},
+			}},
+			cards: []int{0, 1},
+			want: [][]compareCell{
+				{{value: "renewable %"}, {value: "40.50"}, {value: "-"}},
+				{{value: "latency"}, {value: "-"}, {value: "far from the users"}},
+			},
+		},
+		{
+			name:  "prices in different currencies have no winner",
+			pack:  testPack{attrs: []CardAttributes{price(10, "USD"), price(5, "EUR")}},
+			cards: []int{0, 1},
+			want: [][]compareCell{
+				{{value: "price"}, {value: "$10.00"}, {value: "€5.00"}},
+			},
+		},
+		{
+			name:  "prices are converted into the display currency",
+			pack:  testPack{attrs: []CardAttributes{price(10, "USD"), price(6, "EUR")}},
+			cards: []int{0, 1},
+			converter: &currency.Converter{
+				Display: "USD",
+				Locale:  currency.DefaultLocale,
+				Rates:   &currency.Rates{Base: "USD", Rates: map[string]float64{"EUR": 0.5}, UpdatedAt: time.Now()},
+			},
+			want: [][]compareCell{
+				{{value: "price"}, {value: "$10.00", best: true}, {value: "$12.00"}},
+			},
+		},
+		{
+			name: "pack without attributes compares the card fields",
+			pack: testPlainPack{items: []testCard{
+				{upper: "Name: a", lower: "Price: 1"},
+				{upper: "Name: b", lower: "Zone: z"},
+			}},
+			cards: []int{1, 0},
+			want: [][]compareCell{
+				{{value: "Name"}, {value: "b"}, {value: "a"}},
+				{{value: "Zone"}, {value: "z"}, {value: "-"}},
+				{{value: "Price"}, {value: "-"}, {value: "1"}},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.converter != nil {
+				prev := currency.Default()
+				currency.SetDefault(tc.converter)
+				defer currency.SetDefault(prev)
+			}
+
+			if got := compareTable(tc.pack, tc.cards); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+type cardFilter struct {
+	attr CardAttribute
+	// atMost when the value is an upper bound otherwise it is a lower bound
+	atMost bool
+	label  string
+}
+
+// cardFilters are the filters offered by the card runner, in the order of their keybindings
+var cardFilters = []cardFilter{
+	{attr: CardAttrVCpus, label: "min vCPU"},
+	{attr: CardAttrMemory, label: "min memory"},
+	{attr: CardAttrPrice, atMost: true, label: "max price"},
+	{attr: CardAttrCarbonIntensity, atMost: true, label: "max carbon intensity"},
+}
+
+// cardSortKeys are cycled by the sort keybinding, an empty key keeps the order of the pack
+var cardSortKeys = []CardAttribute{"", CardAttrPrice, CardAttrEmissions, CardAttrVCpus}
+
+// fuzzyScore matches the query as a subsequence of the text ignoring the case,
+// the lower the score the closer the characters of the query are in the text
+func fuzzyScore(text, query string) (int, bool) {
+	t := []rune(strings.ToLower(text))
+	q := []rune(strings.ToLower(strings.TrimSpace(query)))
+
+	score, last := 0, -1
+	ti := 0
+	for _, r := range q {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		found := false
+		for ; ti < len(t); ti++ {
+			if t[ti] == r {
+				if last >= 0 {
+					score += ti - last - 1
+				} else {
+					score += ti
+				}
+				last = ti
+				ti++
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+type cardQuery struct {
+	search  string
+	filters map[CardAttribute]float64
+	sortBy  CardAttribute
+	desc    bool
+}
+
+func (q cardQuery) isEmpty() bool {
+	return len(q.search) == 0 && len(q.filters) == 0 && len(q.sortBy) == 0
+}
+
+func (q cardQuery) String() string {
+	parts := []string{}
+	if len(q.search) != 0 {
+		parts = append(parts, fmt.Sprintf("search: %q", q.search))
+	}
+	for _, f := range cardFilters {
+		if v, ok := q.filters[f.attr]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %g", f.label, v))
+		}
+	}
+	if len(q.sortBy) != 0 {
+		order := "↑"
+		if q.desc {
+			order = "↓"
+		}
+		parts = append(parts, fmt.Sprintf("sort: %s %s", q.sortBy, order))
+	}
+	return strings.Join(parts, " • ")
+}
+
+// availableAttributes returns the attributes known for at least one card of the pack
+func availableAttributes(pack AttributedCardPack) map[CardAttribute]bool {
+	res := map[CardAttribute]bool{}
+	for i := 0; i < pack.LenOfItems(); i++ {
+		for a := range pack.GetAttributes(i).Values {
+			res[a] = true
+		}
+	}
+	return res
+}
+
+// apply returns the indexes of the pack which match the query in the order to display them,
+// cards whose attribute is unknown don't pass its filter and are sorted last
+func (q cardQuery) apply(pack CardPack) []int {
+	res := make([]int, 0, pack.LenOfItems())
+
+	ap, ok := pack.(AttributedCardPack)
+	if !ok || q.isEmpty() {
+		for i := 0; i < pack.LenOfItems(); i++ {
+			res = append(res, i)
+		}
+		return res
+	}
+
+	scores := map[int]int{}
+	for i := 0; i < pack.LenOfItems(); i++ {
+		attrs := ap.GetAttributes(i)
+
+		if len(q.search) != 0 {
+			s, ok := fuzzyScore(attrs.SearchText, q.search)
+			if !ok {
+				continue
+			}
+			scores[i] = s
+		}
+
+		matched := true
+		for _, f := range cardFilters {
+			limit, ok := q.filters[f.attr]
+			if !ok {
+				continue
+			}
+			v, ok := attrs.Values[f.attr]
+			if !ok || (f.atMost && v > limit) || (!f.atMost && v < limit) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			res = append(res, i)
+		}
+	}
+
+	slices.SortStableFunc(res, func(a, b int) int {
+		if len(q.sortBy) != 0 {
+			va, okA := ap.GetAttributes(a).Values[q.sortBy]
+			vb, okB := ap.GetAttributes(b).Values[q.sortBy]
+			switch {
+			case okA && !okB:
+				return -1
+			case !okA && okB:
+				return 1
+			case okA && okB && va != vb:
+				if (va < vb) != q.desc {
+					return -1
+				}
+				return 1
+			}
+		}
+		return scores[a] - scores[b]
+	})
+
+	return res
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+type testCard struct {
+	upper, lower string
+}
+
+func (c testCard) GetUpper() string { return c.upper }
+func (c testCard) GetLower() string { return c.lower }
+
+// testPlainPack is a card pack without attributes
+type testPlainPack struct {
+	items []testCard
+}
+
+func (p testPlainPack) LenOfItems() int                  { return len(p.items) }
+func (p testPlainPack) GetItem(i int) CardItem           { return p.items[i] }
+func (p testPlainPack) GetInstruction() string           { return "" }
+func (p testPlainPack) GetResult(i int) string           { return strconv.Itoa(i) }
+func (p testPlainPack) GetCardConfiguration() (int, int) { return 30, 3 }
+
+type testPack struct {
+	attrs []CardAttributes
+}
+
+func (p testPack) LenOfItems() int                  { return len(p.attrs) }
+func (p testPack) GetItem(i int) CardItem           { return testCard{upper: p.attrs[i].SearchText} }
+func (p testPack) GetInstruction() string           { return "" }
+func (p testPack) GetResult(i int) string           { return p.attrs[i].SearchText }
+func (p testPack) GetCardConfiguration() (int, int) { return 30, 3 }
+func (p testPack) GetAttributes(i int) CardAttributes {
+	return p.attrs[i]
+}
+
+func TestFuzzyScore(t *testing.T) {
+	for _, tc := range []struct {
+		text, query string
+		score       int
+		ok          bool
+	}{
+		{"t3.medium", "t3m", 1, true},
+		{"abc", "ABC", 0, true},
+		{"abc", " a c ", 1, true},
+		{"abc", "", 0, true},
+		{"standard_d2s_v3", "d2s", 9, true},
+		{"abc", "acb", 0, false},
+		{"abc", "abcd", 0, false},
+	} {
+		score, ok := fuzzyScore(tc.text, tc.query)
+		if score != tc.score || ok != tc.ok {
+			t.Errorf("fuzzyScore(%q, %q) = %d, %v, want %d, %v", tc.text, tc.query, score, ok, tc.score, tc.ok)
+		}
+	}
+}
+
+func TestCardQueryApply(t *testing.T) {
+	pack := testPack{attrs: []CardAttributes{
+		{SearchText: "t3.small", Values: map[CardAttribute]float64{CardAttrVCpus: 2, CardAttrMemory: 2, CardAttrPrice: 15}},
+		{SearchText: "t3.medium", Values: map[CardAttribute]float64{CardAttrVCpus: 2, CardAttrMemory: 4, CardAttrPrice: 30}},
+		{SearchText: "m5.large", Values: map[CardAttribute]float64{CardAttrVCpus: 2, CardAttrMemory: 8, CardAttrPrice: 70}},
+		{SearchText: "m5.xlarge", Values: map[CardAttribute]float64{CardAttrVCpus: 4, CardAttrMemory: 16}},
+	}}
+
+	for _, tc := range []struct {
+		name  string
+		pack  CardPack
+		query cardQuery
+		want  []int
+	}{
+		{"empty query keeps the order", pack, cardQuery{}, []int{0, 1, 2, 3}},
+		{"search", pack, cardQuery{search: "t3"}, []int{0, 1}},
+		{"search ranks the closer matches first", pack, cardQuery{search: "m"}, []int{2, 3, 1, 0}},
+		{"lower bound filter", pack, cardQuery{filters: map[CardAttribute]float64{CardAttrMemory: 4}}, []int{1, 2, 3}},
+		{"unknown value fails the filter", pack, cardQuery{filters: map[CardAttribute]float64{CardAttrPrice: 50}}, []int{0, 1}},
+		{"filters are combined", pack, cardQuery{filters: map[CardAttribute]float64{CardAttrMemory: 4, CardAttrPrice: 50}}, []int{1}},
+		{"sort ascending puts unknown last", pack, cardQuery{sortBy: CardAttrPrice}, []int{0, 1, 2, 3}},
+		{"sort descending puts unknown last", pack, cardQuery{sortBy: CardAttrPrice, desc: true}, []int{2, 1, 0, 3}},
+		{"sort is stable", pack, cardQuery{sortBy: CardAttrVCpus, desc: true}, []int{3, 0, 1, 2}},
+		{"search and sort", pack, cardQuery{search: "large", sortBy: CardAttrMemory, desc: true}, []int{3, 2}},
+		{"pack without attributes", testPlainPack{items: make([]testCard, 3)}, cardQuery{search: "x"}, []int{0, 1, 2}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.query.apply(tc.pack); !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
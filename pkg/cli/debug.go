@@ -21,6 +21,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/fatih/color"
@@ -30,34 +31,94 @@ type debugMenuDriven struct {
 	progress ProgressAnimation
 }
 
-func (p *debugMenuDriven) CardSelection(element CardPack) (string, error) {
+func (p *debugMenuDriven) CardSelection(element CardPack, opts ...func(*option) error) (string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
 	for i := 0; i < element.LenOfItems(); i++ {
 		e := element.GetItem(i)
 		gg := fmt.Sprintf("--[%d]--%s\n%s\n-------\n", i, e.GetUpper(), e.GetLower())
 		fmt.Println(gg)
 	}
-	fmt.Sprintln(element.GetInstruction())
+	fmt.Println(element.GetInstruction())
 
-	fmt.Printf("Enter the index? for not selecting press -1")
-	var response string
-	_, err := fmt.Scanln(&response)
-	if err != nil {
-		return "", err
-	}
-	if len(response) == 0 {
-		return "", nil
-	}
+	for {
+		fmt.Printf("Enter the index? for not selecting press -1, to compare enter c:<index>,<index>")
+		if o.back {
+			fmt.Printf(", to go back enter %s", BackInput)
+		}
+		var response string
+		_, err = fmt.Scanln(&response)
+		if err != nil {
+			return "", err
+		}
+		if len(response) == 0 {
+			return "", nil
+		}
+		if o.back && response == BackInput {
+			return "", ErrBack
+		}
+
+		if response == "-1" {
+			return "", nil
+		}
+
+		if indexes, ok := strings.CutPrefix(response, "c:"); ok {
+			if err := printComparison(element, indexes); err != nil {
+				fmt.Println(color.HiRedString("Unable to compare: %v", err))
+			}
+			continue
+		}
 
-	if response == "-1" {
-		return "", nil
+		v, err := strconv.Atoi(response)
+		if err != nil {
+			return "", err
+		}
+
+		return element.GetResult(v), nil
 	}
+}
 
-	v, err := strconv.Atoi(response)
-	if err != nil {
-		return "", err
+// printComparison prints the cards at the comma separated indexes side by side, the best value of every row is starred
+func printComparison(element CardPack, indexes string) error {
+	cards := []int{}
+	for _, v := range strings.Split(indexes, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return err
+		}
+		if i < 0 || i >= element.LenOfItems() {
+			return fmt.Errorf("index %d is out of range", i)
+		}
+		cards = append(cards, i)
+	}
+	if len(cards) < minComparedCards || len(cards) > maxComparedCards {
+		return fmt.Errorf("compare %d to %d cards", minComparedCards, maxComparedCards)
 	}
 
-	return element.GetResult(v), nil
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{""}
+	for _, c := range cards {
+		header = append(header, element.GetResult(c))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range compareTable(element, cards) {
+		cols := []string{}
+		for _, c := range row {
+			if c.best {
+				c.value += " *"
+			}
+			cols = append(cols, c.value)
+		}
+		fmt.Fprintln(w, strings.Join(cols, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+	return nil
 }
 
 type debugSpinner struct {
@@ -141,12 +202,18 @@ func (p *debugMenuDriven) Confirmation(prompt string, opts ...func(*option) erro
 	}
 
 	fmt.Println(prompt)
+	if o.back {
+		fmt.Printf("To go back enter %s\n", BackInput)
+	}
 	fmt.Printf("Proceed? [y/N]{default: %s}: ", color.HiGreenString(o.defaultValue))
 	var response string
 	_, err = fmt.Scanln(&response)
 	if err != nil {
 		return false, err
 	}
+	if o.back && response == BackInput {
+		return false, ErrBack
+	}
 	if len(response) == 0 {
 		if o.defaultValue != "" {
 			response = o.defaultValue
@@ -169,33 +236,66 @@ func (p *debugMenuDriven) TextInput(prompt string, opts ...func(*option) error)
 		return "", err
 	}
 
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("%s {default: %s}: ", prompt, color.HiGreenString(o.defaultValue))
-	response, err := reader.ReadString('\n')
-	if err != nil && io.EOF != err {
-		return "", err
+	if o.back {
+		prompt += fmt.Sprintf(" (enter %s to go back)", BackInput)
 	}
-	response = strings.TrimSpace(response)
 
-	if len(response) == 0 {
-		if o.defaultValue != "" {
-			response = o.defaultValue
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Printf("%s {default: %s}: ", prompt, color.HiGreenString(o.defaultValue))
+		response, err := reader.ReadString('\n')
+		if err != nil && io.EOF != err {
+			return "", err
 		}
-	}
+		eof := err == io.EOF
+		response = strings.TrimSpace(response)
 
-	fmt.Println("Got response:", response)
-	fmt.Println()
+		if o.back && response == BackInput {
+			return "", ErrBack
+		}
 
-	return response, nil
+		if len(response) == 0 {
+			if o.defaultValue != "" {
+				response = o.defaultValue
+			}
+		}
+
+		if o.validate != nil {
+			if err := o.validate(response); err != nil {
+				if eof {
+					return "", fmt.Errorf("invalid input %q: %w", response, err)
+				}
+				fmt.Println(color.HiRedString("Invalid input: %v", err))
+				continue
+			}
+		}
+
+		fmt.Println("Got response:", response)
+		fmt.Println()
+
+		return response, nil
+	}
 }
 
-func (p *debugMenuDriven) TextInputPassword(prompt string) (string, error) {
+func (p *debugMenuDriven) TextInputPassword(prompt string, opts ...func(*option) error) (string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
+	if o.back {
+		prompt += fmt.Sprintf(" (enter %s to go back)", BackInput)
+	}
+
 	fmt.Println(prompt)
 	var response string
-	_, err := fmt.Scanln(&response)
+	_, err = fmt.Scanln(&response)
 	if err != nil {
 		return "", err
 	}
+	if o.back && response == BackInput {
+		return "", ErrBack
+	}
 
 	return response, nil
 }
@@ -207,6 +307,9 @@ func (p *debugMenuDriven) DropDown(prompt string, options map[string]string, opt
 	}
 
 	fmt.Printf("%s {default: %s}\n", prompt, color.HiGreenString(o.defaultValue))
+	if o.back {
+		fmt.Printf("To go back enter %s\n", BackInput)
+	}
 	fmt.Println("Options[make sure to enter value and not the key]:")
 	for k, v := range options {
 		fmt.Printf("%s: %s\n", k, color.HiCyanString(v))
@@ -218,6 +321,10 @@ func (p *debugMenuDriven) DropDown(prompt string, options map[string]string, opt
 		return "", err
 	}
 
+	if o.back && response == BackInput {
+		return "", ErrBack
+	}
+
 	if len(response) == 0 {
 		if o.defaultValue != "" {
 			response = o.defaultValue
@@ -237,6 +344,9 @@ func (p *debugMenuDriven) DropDownList(prompt string, options []string, opts ...
 	}
 
 	fmt.Printf("%s {default: %s}\n", prompt, color.HiGreenString(o.defaultValue))
+	if o.back {
+		fmt.Printf("To go back enter %s\n", BackInput)
+	}
 	fmt.Println("Options:")
 	for _, v := range options {
 		fmt.Println(color.HiCyanString(v))
@@ -248,6 +358,10 @@ func (p *debugMenuDriven) DropDownList(prompt string, options []string, opts ...
 		return "", err
 	}
 
+	if o.back && response == BackInput {
+		return "", ErrBack
+	}
+
 	if len(response) == 0 {
 		if o.defaultValue != "" {
 			response = o.defaultValue
@@ -261,16 +375,27 @@ func (p *debugMenuDriven) DropDownList(prompt string, options []string, opts ...
 }
 
 func (p *debugMenuDriven) MultiSelect(prompt string, options map[string]string, opts ...func(*option) error) ([]string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.back {
+		fmt.Printf("To go back enter %s\n", BackInput)
+	}
 	fmt.Println("Options[make sure to enter value and not the key]:")
 	for k, v := range options {
 		fmt.Printf("%s: %s\n", k, color.HiCyanString(v))
 	}
 
 	var response string
-	_, err := fmt.Scanln(&response)
+	_, err = fmt.Scanln(&response)
 	if err != nil {
 		return nil, err
 	}
+	if o.back && response == BackInput {
+		return nil, ErrBack
+	}
 
 	if len(response) == 0 {
 		return nil, fmt.Errorf("No response provided")
@@ -14,7 +14,17 @@
 
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	OutputTable = "table"
+	OutputJson  = "json"
+	OutputCsv   = "csv"
+)
 
 func MarkFlagsRequired(command *cobra.Command, flagNames ...string) error {
 	for _, flagName := range flagNames {
@@ -32,3 +42,8 @@ func AddVerboseFlag(command *cobra.Command, verbose *bool) {
 func AddDebugMode(command *cobra.Command, debugRun *bool) {
 	command.PersistentFlags().BoolVar(debugRun, "debug-cli", false, "Its used to run debug mode against cli's menudriven interface")
 }
+
+// AddOutputFormatFlag adds the output flag where the first format is the default one
+func AddOutputFormatFlag(command *cobra.Command, output *string, formats ...string) {
+	command.Flags().StringVarP(output, "output", "o", formats[0], "Output format ("+strings.Join(formats, "|")+")")
+}
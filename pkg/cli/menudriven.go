@@ -14,6 +14,8 @@
 
 package cli
 
+import "errors"
+
 type ProgressAnimation interface {
 	Start(msg ...any)
 	StopWithSuccess(msg ...any)
@@ -23,6 +25,8 @@ type ProgressAnimation interface {
 
 type option struct {
 	defaultValue string
+	back         bool
+	validate     func(string) error
 }
 
 func WithDefaultValue(defaultValue string) func(*option) error {
@@ -32,6 +36,28 @@ func WithDefaultValue(defaultValue string) func(*option) error {
 	}
 }
 
+// ErrBack is returned by the prompts shown WithBackOption when the user wants to go back
+var ErrBack = errors.New("go back to the previous step")
+
+// BackInput is the text to enter to go back in the text prompts
+const BackInput = "<"
+
+// WithBackOption lets the user go back from the prompt, the prompt returns ErrBack when they do
+func WithBackOption() func(*option) error {
+	return func(o *option) error {
+		o.back = true
+		return nil
+	}
+}
+
+// WithValidator re-prompts the text input with the reason till the validator accepts the value
+func WithValidator(validate func(string) error) func(*option) error {
+	return func(o *option) error {
+		o.validate = validate
+		return nil
+	}
+}
+
 type CardItem interface {
 	GetUpper() string
 	GetLower() string
@@ -45,15 +71,44 @@ type CardPack interface {
 	GetCardConfiguration() (cardWidth, noOfVisibleItems int)
 }
 
+type CardAttribute string
+
+const (
+	CardAttrVCpus  CardAttribute = "vCPU"
+	CardAttrMemory CardAttribute = "memory"
+	CardAttrPrice  CardAttribute = "price"
+	// CardAttrEmissions is the embodied emissions of the instance or the carbon intensity of the region
+	CardAttrEmissions       CardAttribute = "emissions"
+	CardAttrCarbonIntensity CardAttribute = "carbon intensity"
+	CardAttrRenewable       CardAttribute = "renewable %"
+)
+
+// CardAttributes are used to search, filter and sort the cards,
+// an attribute missing from the values is unknown for the card
+type CardAttributes struct {
+	SearchText string
+	Values     map[CardAttribute]float64
+	// Currency of the price
+	Currency string
+	// LatencyNotes tells how choosing the card affects the latency, shown when comparing the cards
+	LatencyNotes string
+}
+
+// AttributedCardPack is implemented by the card packs whose cards can be searched, filtered and sorted
+type AttributedCardPack interface {
+	CardPack
+	GetAttributes(i int) CardAttributes
+}
+
 type MenuDriven interface {
 	GetProgressAnimation() ProgressAnimation
 	Confirmation(prompt string, opts ...func(*option) error) (proceed bool, err error)
 	TextInput(prompt string, opts ...func(*option) error) (string, error)
-	TextInputPassword(prompt string) (string, error)
+	TextInputPassword(prompt string, opts ...func(*option) error) (string, error)
 	DropDown(prompt string, options map[string]string, opts ...func(*option) error) (string, error)
 	DropDownList(prompt string, options []string, opts ...func(*option) error) (string, error)
 	MultiSelect(prompt string, options map[string]string, opts ...func(*option) error) ([]string, error)
-	CardSelection(element CardPack) (string, error)
+	CardSelection(element CardPack, opts ...func(*option) error) (string, error)
 }
 
 func processOptions(opts []func(*option) error) (option, error) {
@@ -17,11 +17,15 @@ package cli
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/pterm/pterm"
 )
 
+// backOption is appended to the options of the drop downs shown WithBackOption
+const backOption = "⬅ Back"
+
 type genericMenuDriven struct {
 	progress ProgressAnimation
 }
@@ -82,6 +86,25 @@ func (p *genericMenuDriven) Confirmation(prompt string, opts ...func(*option) er
 		return false, err
 	}
 
+	if o.back {
+		// the confirm prompt only has yes and no, the back option needs the select one
+		options := []string{"Yes", "No", backOption}
+		x := pterm.DefaultInteractiveSelect.WithOptions(options)
+		if o.defaultValue == "yes" {
+			x = x.WithDefaultOption("Yes")
+		} else if len(o.defaultValue) != 0 {
+			x = x.WithDefaultOption("No")
+		}
+		v, err := x.Show(prompt)
+		if err != nil {
+			return false, err
+		}
+		if v == backOption {
+			return false, ErrBack
+		}
+		return v == "Yes", nil
+	}
+
 	x := pterm.DefaultInteractiveConfirm
 	if len(o.defaultValue) != 0 {
 		x = *x.WithDefaultValue(o.defaultValue == "yes")
@@ -95,16 +118,52 @@ func (p *genericMenuDriven) TextInput(prompt string, opts ...func(*option) error
 		return "", err
 	}
 
-	if len(o.defaultValue) == 0 {
-		return pterm.DefaultInteractiveTextInput.Show(prompt)
+	if o.back {
+		prompt += fmt.Sprintf(" (enter %s to go back)", BackInput)
+	}
+
+	x := &pterm.DefaultInteractiveTextInput
+	if len(o.defaultValue) != 0 {
+		x = x.WithDefaultValue(o.defaultValue)
+	}
+
+	for {
+		v, err := x.Show(prompt)
+		if err != nil {
+			return "", err
+		}
+		if o.back && strings.TrimSpace(v) == BackInput {
+			return "", ErrBack
+		}
+		if o.validate != nil {
+			if err := o.validate(v); err != nil {
+				pterm.Error.Println(err.Error())
+				continue
+			}
+		}
+		return v, nil
 	}
-	x := pterm.DefaultInteractiveTextInput.WithDefaultValue(o.defaultValue)
-	return x.Show(prompt)
 }
 
-func (p *genericMenuDriven) TextInputPassword(prompt string) (string, error) {
+func (p *genericMenuDriven) TextInputPassword(prompt string, opts ...func(*option) error) (string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return "", err
+	}
+
+	if o.back {
+		prompt += fmt.Sprintf(" (enter %s to go back)", BackInput)
+	}
+
 	x := pterm.DefaultInteractiveTextInput.WithMask("*")
-	return x.Show(prompt)
+	v, err := x.Show(prompt)
+	if err != nil {
+		return "", err
+	}
+	if o.back && strings.TrimSpace(v) == BackInput {
+		return "", ErrBack
+	}
+	return v, nil
 }
 
 func (p *genericMenuDriven) DropDown(prompt string, options map[string]string, opts ...func(*option) error) (string, error) {
@@ -117,6 +176,9 @@ func (p *genericMenuDriven) DropDown(prompt string, options map[string]string, o
 	for k := range options {
 		_options = append(_options, k)
 	}
+	if o.back {
+		_options = append(_options, backOption)
+	}
 
 	x := pterm.DefaultInteractiveSelect.WithOptions(_options)
 	if len(o.defaultValue) != 0 {
@@ -131,6 +193,8 @@ func (p *genericMenuDriven) DropDown(prompt string, options map[string]string, o
 
 	if v, err := x.Show(prompt); err != nil {
 		return "", err
+	} else if o.back && v == backOption {
+		return "", ErrBack
 	} else {
 		return options[v], nil
 	}
@@ -143,6 +207,10 @@ func (p *genericMenuDriven) DropDownList(prompt string, options []string, opts .
 		return "", err
 	}
 
+	if o.back {
+		options = append(options[:len(options):len(options)], backOption)
+	}
+
 	x := pterm.DefaultInteractiveSelect.WithOptions(options)
 	if len(o.defaultValue) != 0 {
 		x = x.WithDefaultOption(o.defaultValue)
@@ -150,22 +218,35 @@ func (p *genericMenuDriven) DropDownList(prompt string, options []string, opts .
 
 	if v, err := x.Show(prompt); err != nil {
 		return "", err
+	} else if o.back && v == backOption {
+		return "", ErrBack
 	} else {
 		return v, nil
 	}
 }
 
 func (p *genericMenuDriven) MultiSelect(prompt string, options map[string]string, opts ...func(*option) error) ([]string, error) {
+	o, err := processOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	var _options []string
 	for k, _ := range options {
 		_options = append(_options, k)
 	}
+	if o.back {
+		_options = append(_options, backOption)
+	}
 
 	x := pterm.DefaultInteractiveMultiselect.WithOptions(_options)
 
 	if v, err := x.Show(prompt); err != nil {
 		return nil, err
 	} else {
+		if o.back && slices.Contains(v, backOption) {
+			return nil, ErrBack
+		}
 		if len(v) == 0 {
 			return nil, fmt.Errorf("no options selected")
 		}
@@ -0,0 +1,54 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+type BudgetEnforcement string
+
+const (
+	BudgetWarn  BudgetEnforcement = "warn"
+	BudgetBlock BudgetEnforcement = "block"
+)
+
+// Budget holds the monthly spending limits, a zero limit means there is no limit
+type Budget struct {
+	AccountMonthly float64            `json:"accountMonthly,omitempty"`
+	ClusterMonthly float64            `json:"clusterMonthly,omitempty"`
+	Clusters       map[string]float64 `json:"clusters,omitempty"`
+	Enforcement    BudgetEnforcement  `json:"enforcement,omitempty"`
+	// Currency of the limits, the costs are converted into it before they are compared
+	Currency string `json:"currency,omitempty"`
+}
+
+func (b *Budget) IsConfigured() bool {
+	if b == nil {
+		return false
+	}
+	return b.AccountMonthly > 0 || b.ClusterMonthly > 0 || len(b.Clusters) > 0
+}
+
+// LimitForCluster returns the per-cluster override if present otherwise the default per-cluster limit
+func (b *Budget) LimitForCluster(clusterName string) float64 {
+	if b == nil {
+		return 0
+	}
+	if v, ok := b.Clusters[clusterName]; ok {
+		return v
+	}
+	return b.ClusterMonthly
+}
+
+func (b *Budget) IsBlocking() bool {
+	return b != nil && b.Enforcement == BudgetBlock
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+)
+
+// ClusterRecord is the cli owned information about a cluster, it is kept in the state store next to the cluster state
+type ClusterRecord struct {
+	Name          string                  `json:"name"`
+	CloudProvider consts.KsctlCloud       `json:"cloudProvider"`
+	ClusterType   consts.KsctlClusterType `json:"clusterType"`
+	Region        string                  `json:"region"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	// WorkerPools are the named worker pools of a self-managed cluster, the worker
+	// nodes which are in none of them belong to the default pool
+	WorkerPools []WorkerPool `json:"workerPools,omitempty"`
+	// Schedule scales the worker nodes of a self-managed cluster at the planned times
+	Schedule *ScaleSchedule `json:"schedule,omitempty"`
+	// ExpiresAt is when the cluster is due to be reaped, nil when it never expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Protected clusters refuse the destructive operations unless they are overridden
+	Protected bool `json:"protected,omitempty"`
+	// Labels, Owner and Notes tell who owns the cluster and what it is for
+	Labels map[string]string `json:"labels,omitempty"`
+	Owner  string            `json:"owner,omitempty"`
+	Notes  string            `json:"notes,omitempty"`
+}
+
+// ScaleRule sets the number of worker nodes when the cron expression fires
+type ScaleRule struct {
+	Cron    string `json:"cron"`
+	Workers int    `json:"workers"`
+}
+
+// ScaleSchedule is the scaling plan of a cluster run by the scheduler
+type ScaleSchedule struct {
+	Rules []ScaleRule `json:"rules"`
+	// InstanceType of the worker nodes added by the schedule
+	InstanceType string `json:"instanceType"`
+}
+
+// WorkerPool is a named group of worker nodes with the same instance type, labels and taints
+type WorkerPool struct {
+	Name         string `json:"name"`
+	InstanceType string `json:"instanceType"`
+	// Count is the number of nodes of the pool when the cluster is created
+	Count int `json:"count,omitempty"`
+	// Nodes are the names of the worker nodes of the pool
+	Nodes  []string          `json:"nodes,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	// Taints are in the form key=value:Effect or key:Effect
+	Taints []string `json:"taints,omitempty"`
+}
+
+// WorkerPool returns the pool with the name
+func (r *ClusterRecord) WorkerPool(name string) (*WorkerPool, bool) {
+	for i := range r.WorkerPools {
+		if r.WorkerPools[i].Name == name {
+			return &r.WorkerPools[i], true
+		}
+	}
+	return nil, false
+}
+
+type ClusterRecords struct {
+	Clusters map[string]*ClusterRecord `json:"clusters"`
+}
+
+func ClusterRecordKey(name string, cloud consts.KsctlCloud, clusterType consts.KsctlClusterType, region string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", cloud, clusterType, region, name)
+}
+
+// Key is the key of the record in the cluster records
+func (r *ClusterRecord) Key() string {
+	return ClusterRecordKey(r.Name, r.CloudProvider, r.ClusterType, r.Region)
+}
+
+func (c *ClusterRecords) Get(key string) (*ClusterRecord, bool) {
+	if c.Clusters == nil {
+		return nil, false
+	}
+	v, ok := c.Clusters[key]
+	return v, ok
+}
+
+func (c *ClusterRecords) Delete(key string) {
+	delete(c.Clusters, key)
+}
+
+// ClusterRecordStore keeps the cluster records in the state store of the clusters, so every
+// machine using the same state store sees the same records
+type ClusterRecordStore interface {
+	Load(c *ClusterRecords) error
+	// Update applies the change to the record of the cluster, the record is created from init when missing
+	Update(init ClusterRecord, change func(*ClusterRecord)) error
+	Delete(key string) error
+}
+
+// NewClusterRecordStore returns the records of the state store, the external ones read their credentials
+func NewClusterRecordStore(ctx context.Context, store consts.KsctlStore) (ClusterRecordStore, error) {
+	switch store {
+	case consts.StoreLocal:
+		return localClusterRecords{}, nil
+	case consts.StoreExtMongo:
+		return newMongoClusterRecords(ctx)
+	default:
+		return nil, fmt.Errorf("no cluster records for the state store %q", store)
+	}
+}
+
+// localClusterRecordsMu serializes the changes of the records file by the operations running in parallel,
+// the file lock serializes them with the other ksctl processes like the scheduler
+var localClusterRecordsMu sync.Mutex
+
+// localClusterRecords keeps the records of the clusters in the local state store in the config dir
+type localClusterRecords struct{}
+
+func locateClusterRecords() (string, error) {
+	return locateConfigFile("clusters.json")
+}
+
+func (localClusterRecords) Load(c *ClusterRecords) error {
+	configFile, err := locateClusterRecords()
+	if err != nil {
+		return err
+	}
+
+	if err := readJson(configFile, c); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read the cluster records %s: %v", configFile, err)
+	}
+	if c.Clusters == nil {
+		c.Clusters = make(map[string]*ClusterRecord)
+	}
+	return nil
+}
+
+// modify applies the change to the records while no other process can change them
+func (l localClusterRecords) modify(change func(*ClusterRecords) bool) error {
+	localClusterRecordsMu.Lock()
+	defer localClusterRecordsMu.Unlock()
+
+	configFile, err := locateClusterRecords()
+	if err != nil {
+		return err
+	}
+	unlock, err := lockFile(configFile+".lock", false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	c := new(ClusterRecords)
+	if err := l.Load(c); err != nil {
+		return err
+	}
+	if !change(c) {
+		return nil
+	}
+	return writeJson(configFile, c)
+}
+
+func (l localClusterRecords) Update(init ClusterRecord, change func(*ClusterRecord)) error {
+	return l.modify(func(c *ClusterRecords) bool {
+		key := init.Key()
+		if _, ok := c.Clusters[key]; !ok {
+			c.Clusters[key] = &init
+		}
+		change(c.Clusters[key])
+		return true
+	})
+}
+
+func (l localClusterRecords) Delete(key string) error {
+	return l.modify(func(c *ClusterRecords) bool {
+		if _, ok := c.Get(key); !ok {
+			return false
+		}
+		c.Delete(key)
+		return true
+	})
+}
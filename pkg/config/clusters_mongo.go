@@ -0,0 +1,156 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/statefile"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	mongoRecordsDatabase   = "ksctl-cli"
+	mongoRecordsCollection = "clusters"
+	mongoTimeout           = 15 * time.Second
+	// mongoUpdateRetries is how many times a record changed by another machine meanwhile is updated again
+	mongoUpdateRetries = 5
+)
+
+type mongoClusterRecord struct {
+	Key string `bson:"_id"`
+	// Revision is increased by every update, an update of an older revision is retried
+	Revision int64         `bson:"revision"`
+	Record   ClusterRecord `bson:"record"`
+}
+
+// mongoClusterRecords keeps the records of the clusters in the external mongodb state store
+type mongoClusterRecords struct {
+	ctx  context.Context
+	coll *mongo.Collection
+}
+
+func mongoURI(c *statefile.CredentialsMongodb) string {
+	u := url.URL{Scheme: "mongodb", User: url.UserPassword(c.Username, c.Password), Host: c.Domain}
+	if c.SRV {
+		u.Scheme = "mongodb+srv"
+	} else if c.Port != nil {
+		u.Host = net.JoinHostPort(c.Domain, strconv.Itoa(*c.Port))
+	}
+	return u.String()
+}
+
+func newMongoClusterRecords(ctx context.Context) (*mongoClusterRecords, error) {
+	c := new(statefile.CredentialsMongodb)
+	if err := LoadStorageCreds(c, consts.StoreExtMongo); err != nil {
+		return nil, fmt.Errorf("failed to load the mongodb credentials: %w", err)
+	}
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI(c)).SetTimeout(mongoTimeout))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+
+	return &mongoClusterRecords{
+		ctx:  ctx,
+		coll: client.Database(mongoRecordsDatabase).Collection(mongoRecordsCollection),
+	}, nil
+}
+
+func (s *mongoClusterRecords) Load(c *ClusterRecords) error {
+	ctx, cancel := context.WithTimeout(s.ctx, mongoTimeout)
+	defer cancel()
+
+	cur, err := s.coll.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("failed to read the cluster records: %w", err)
+	}
+	docs := []mongoClusterRecord{}
+	if err := cur.All(ctx, &docs); err != nil {
+		return fmt.Errorf("failed to read the cluster records: %w", err)
+	}
+
+	c.Clusters = make(map[string]*ClusterRecord, len(docs))
+	for _, d := range docs {
+		c.Clusters[d.Key] = &d.Record
+	}
+	return nil
+}
+
+func (s *mongoClusterRecords) Update(init ClusterRecord, change func(*ClusterRecord)) error {
+	key := init.Key()
+	for range mongoUpdateRetries {
+		ok, err := s.update(key, init, change)
+		if err != nil || ok {
+			return err
+		}
+	}
+	return fmt.Errorf("the record of the cluster %s is being changed by others, try again", init.Name)
+}
+
+// update applies the change to the current revision of the record, it is not ok when the record changed meanwhile
+func (s *mongoClusterRecords) update(key string, init ClusterRecord, change func(*ClusterRecord)) (bool, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, mongoTimeout)
+	defer cancel()
+
+	doc := mongoClusterRecord{}
+	err := s.coll.FindOne(ctx, bson.M{"_id": key}).Decode(&doc)
+	exists := err == nil
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		doc = mongoClusterRecord{Key: key, Record: init}
+	case err != nil:
+		return false, fmt.Errorf("failed to read the cluster record: %w", err)
+	}
+
+	rev := doc.Revision
+	change(&doc.Record)
+	doc.Revision = rev + 1
+
+	if !exists {
+		if _, err := s.coll.InsertOne(ctx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to write the cluster record: %w", err)
+		}
+		return true, nil
+	}
+
+	res, err := s.coll.ReplaceOne(ctx, bson.M{"_id": key, "revision": rev}, doc)
+	if err != nil {
+		return false, fmt.Errorf("failed to write the cluster record: %w", err)
+	}
+	return res.MatchedCount == 1, nil
+}
+
+func (s *mongoClusterRecords) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(s.ctx, mongoTimeout)
+	defer cancel()
+
+	if _, err := s.coll.DeleteOne(ctx, bson.M{"_id": key}); err != nil {
+		return fmt.Errorf("failed to delete the cluster record: %w", err)
+	}
+	return nil
+}
@@ -37,6 +37,8 @@ import (
 type Config struct {
 	PreferedStateStore consts.KsctlStore `json:"preferedStateStore"`
 	Telemetry          *bool             `json:"telemetry,omitempty"`
+	Budget             *Budget           `json:"budget,omitempty"`
+	Currency           *Currency         `json:"currency,omitempty"`
 }
 
 func LoadConfig(c *Config) (errC error) {
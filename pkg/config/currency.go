@@ -0,0 +1,105 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ksctl/cli/v2/pkg/currency"
+)
+
+// Currency is the display preference for the prices
+type Currency struct {
+	Display string `json:"display,omitempty"`
+	// Locale is the language tag used for the formatting, when empty it is derived from the environment
+	Locale string `json:"locale,omitempty"`
+	// RatesFile is the local exchange rates table, it is imported into the rates cache
+	RatesFile string `json:"ratesFile,omitempty"`
+}
+
+// RatesMaxAge is the age after which the cached exchange rates are reported as stale
+const RatesMaxAge = 30 * 24 * time.Hour
+
+func locateRatesCacheFile() (string, error) {
+	return locateConfigFile("cache-currency-rates.json")
+}
+
+// ReadRatesFile reads the exchange rates table from the file, the modification time is used when it has no timestamp
+func ReadRatesFile(path string) (*currency.Rates, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	r := new(currency.Rates)
+	if err := json.NewDecoder(file).Decode(r); err != nil {
+		return nil, fmt.Errorf("failed to parse rates from %s: %v", path, err)
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	if r.UpdatedAt.IsZero() {
+		if st, err := file.Stat(); err == nil {
+			r.UpdatedAt = st.ModTime().UTC()
+		}
+	}
+	if len(r.Source) == 0 {
+		r.Source = path
+	}
+	return r, nil
+}
+
+// LoadRatesCache returns nil rates when nothing was cached yet
+func LoadRatesCache() (*currency.Rates, error) {
+	configFile, err := locateRatesCacheFile()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(configFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open file %s: %v", configFile, err)
+	}
+	defer file.Close()
+
+	r := new(currency.Rates)
+	if err := json.NewDecoder(file).Decode(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func SaveRatesCache(r *currency.Rates) error {
+	configFile, err := locateRatesCacheFile()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(configFile)
+	if err != nil {
+		return fmt.Errorf("failed to create file %s: %v", configFile, err)
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(r)
+}
@@ -0,0 +1,164 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ksctl/ksctl/v2/pkg/handler/cluster/controller"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+// ClusterSpec is everything answered in the cluster create wizard, the metadata
+// holds the chosen cni along with its configuration in the addons
+type ClusterSpec struct {
+	Metadata controller.Metadata `json:"metadata"`
+	// Archs is the cpu architecture chosen for every role, empty means any
+	Archs map[string]provider.MachineArch `json:"archs,omitempty"`
+	// Instances is the instance type chosen for every role
+	Instances       map[string]provider.InstanceRegionOutput `json:"instances,omitempty"`
+	ManagedOffering *provider.ManagedClusterOutput           `json:"managedOffering,omitempty"`
+	// RecommendedCounts is the number of nodes suggested for the role by the workload sizing
+	RecommendedCounts map[string]int `json:"recommendedCounts,omitempty"`
+	// WorkerPools are the worker pools of a self-managed cluster, the first one is made of
+	// the worker nodes of the metadata and the instance types of the others are in Instances
+	WorkerPools []WorkerPool `json:"workerPools,omitempty"`
+}
+
+// Draft is a partially answered cluster create wizard
+type Draft struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Answered are the steps of the wizard already answered
+	Answered []string `json:"answered"`
+	// Completed is set once the blueprint is reviewed
+	Completed bool `json:"completed"`
+	// Preset pre-filled the answers, it is applied again when the draft is resumed
+	Preset string      `json:"preset,omitempty"`
+	Spec   ClusterSpec `json:"spec"`
+}
+
+// fileNameRegex is the allowed names of the drafts and the presets, they are used as the file names
+var fileNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]{0,62})$`)
+
+func IsValidDraftName(name string) bool {
+	return fileNameRegex.MatchString(name)
+}
+
+func locateDrafts() (string, error) {
+	return locateConfigDir("drafts")
+}
+
+func locateDraft(name string) (string, error) {
+	if !IsValidDraftName(name) {
+		return "", fmt.Errorf("invalid draft name %q", name)
+	}
+	dir, err := locateDrafts()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+func LoadDraft(name string, d *Draft) error {
+	path, err := locateDraft(name)
+	if err != nil {
+		return err
+	}
+	if err := readJson(path, d); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("draft %s not found", name)
+		}
+		return fmt.Errorf("failed to read the draft %s: %v", name, err)
+	}
+	return nil
+}
+
+func SaveDraft(d *Draft) error {
+	path, err := locateDraft(d.Name)
+	if err != nil {
+		return err
+	}
+
+	d.UpdatedAt = time.Now().UTC()
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = d.UpdatedAt
+	}
+	return writeJson(path, d)
+}
+
+func DeleteDraft(name string) error {
+	path, err := locateDraft(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("draft %s not found", name)
+		}
+		return err
+	}
+	return nil
+}
+
+// ListDrafts returns the drafts ordered by the last update, the most recent first
+func ListDrafts() ([]Draft, error) {
+	dir, err := locateDrafts()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Draft{}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if e.IsDir() || !ok {
+			continue
+		}
+		d := Draft{}
+		if err := LoadDraft(name, &d); err != nil {
+			return nil, err
+		}
+		res = append(res, d)
+	}
+
+	slices.SortFunc(res, func(a, b Draft) int {
+		return b.UpdatedAt.Compare(a.UpdatedAt)
+	})
+	return res, nil
+}
+
+// ExportClusterSpec writes the spec to the file so that it can be reused to create clusters
+func ExportClusterSpec(path string, s ClusterSpec) error {
+	return writeJson(path, s)
+}
+
+func LoadClusterSpec(path string, s *ClusterSpec) error {
+	if err := readJson(path, s); err != nil {
+		return fmt.Errorf("failed to read the cluster spec %s: %v", path, err)
+	}
+	return nil
+}
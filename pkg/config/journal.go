@@ -0,0 +1,154 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+)
+
+// JournalEntry is an action taken by the scheduler
+type JournalEntry struct {
+	Time          time.Time         `json:"time"`
+	Cluster       string            `json:"cluster"`
+	CloudProvider consts.KsctlCloud `json:"cloudProvider"`
+	Region        string            `json:"region"`
+	Action        string            `json:"action"`
+	From          int               `json:"from"`
+	To            int               `json:"to"`
+	Rule          string            `json:"rule"`
+	Error         string            `json:"error,omitempty"`
+}
+
+// SchedulerDir is where the scheduler keeps its journal, log and pid file
+func SchedulerDir() (string, error) {
+	return locateConfigDir("scheduler")
+}
+
+// ErrSchedulerRunning is returned when another scheduler holds the lock
+var ErrSchedulerRunning = errors.New("scheduler is already running")
+
+// LockScheduler makes sure only one scheduler runs on the machine, the pid of the holder is
+// written next to the lock. The returned func releases it
+func LockScheduler() (func(), error) {
+	dir, err := SchedulerDir()
+	if err != nil {
+		return nil, err
+	}
+	unlock, err := lockFile(filepath.Join(dir, "scheduler.lock"), true)
+	if err != nil {
+		if errors.Is(err, errLocked) {
+			return nil, ErrSchedulerRunning
+		}
+		return nil, err
+	}
+
+	pidFile := filepath.Join(dir, "scheduler.pid")
+	if err := os.WriteFile(pidFile, []byte(strconv.Itoa(os.Getpid())), 0644); err != nil {
+		unlock()
+		return nil, fmt.Errorf("failed to write the pid file %s: %v", pidFile, err)
+	}
+	return func() {
+		_ = os.Remove(pidFile)
+		unlock()
+	}, nil
+}
+
+// RunningScheduler returns the pid of the scheduler holding the lock, it is not ok when none runs
+func RunningScheduler() (int, bool, error) {
+	dir, err := SchedulerDir()
+	if err != nil {
+		return 0, false, err
+	}
+	unlock, err := lockFile(filepath.Join(dir, "scheduler.lock"), true)
+	switch {
+	case err == nil:
+		unlock()
+		return 0, false, nil
+	case !errors.Is(err, errLocked):
+		return 0, false, err
+	}
+
+	v, err := os.ReadFile(filepath.Join(dir, "scheduler.pid"))
+	if err != nil {
+		return 0, true, fmt.Errorf("failed to read the pid of the running scheduler: %v", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(v)))
+	if err != nil {
+		return 0, true, fmt.Errorf("invalid pid of the running scheduler %q", v)
+	}
+	return pid, true, nil
+}
+
+func locateJournal() (string, error) {
+	dir, err := SchedulerDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "journal.jsonl"), nil
+}
+
+// AppendJournal adds the entry to the end of the journal
+func AppendJournal(e JournalEntry) error {
+	path, err := locateJournal()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(e)
+}
+
+// ReadJournal returns the entries of the journal, oldest first
+func ReadJournal() ([]JournalEntry, error) {
+	path, err := locateJournal()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open file %s: %v", path, err)
+	}
+	defer file.Close()
+
+	res := []JournalEntry{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		e := JournalEntry{}
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("invalid journal entry %q: %v", scanner.Text(), err)
+		}
+		res = append(res, e)
+	}
+	return res, scanner.Err()
+}
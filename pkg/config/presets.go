@@ -0,0 +1,235 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ksctl/ksctl/v2/pkg/consts"
+	"github.com/ksctl/ksctl/v2/pkg/provider"
+)
+
+// PresetRole is the shape of the nodes of a role, the instance type is the cheapest one
+// of the category with at least the vCPUs and memory unless it is pinned and offered
+type PresetRole struct {
+	Count    int                      `json:"count"`
+	Category provider.MachineCategory `json:"category,omitempty"`
+	VCpus    int                      `json:"vcpus,omitempty"`
+	// Memory in GB
+	Memory int `json:"memory,omitempty"`
+	// InstanceType pins the instance type, the shape is used when the region doesn't offer it
+	// and the user picks the instance type when the role has no shape
+	InstanceType string `json:"instanceType,omitempty"`
+}
+
+// PresetAddon is an addon installed along with the cluster
+type PresetAddon struct {
+	Name   string         `json:"name"`
+	Label  string         `json:"label,omitempty"`
+	Config map[string]any `json:"config,omitempty"`
+}
+
+// Preset pre-fills the answers of the cluster create wizard
+type Preset struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description"`
+	ClusterType consts.KsctlClusterType `json:"clusterType"`
+	// Provider is asked when empty
+	Provider  consts.KsctlCloud      `json:"provider,omitempty"`
+	K8sDistro consts.KsctlKubernetes `json:"k8sDistro,omitempty"`
+	// Arch is the cpu architecture of every node, empty means any
+	Arch provider.MachineArch `json:"arch,omitempty"`
+	// Roles is the shape of the nodes of every role of the cluster type
+	Roles map[string]PresetRole `json:"roles"`
+	// ManagedOffering pins the managed offering, the cheapest one is used otherwise
+	ManagedOffering string `json:"managedOffering,omitempty"`
+	// CNI is the name of the cni, empty means the default of the offering
+	CNI string `json:"cni,omitempty"`
+	// CiliumGuided are the cilium guided configurations when the cni is cilium
+	CiliumGuided []string      `json:"ciliumGuided,omitempty"`
+	Addons       []PresetAddon `json:"addons,omitempty"`
+
+	Builtin bool `json:"-"`
+}
+
+// The roles of the nodes in the presets, they are the same as the roles of the cluster spec
+const (
+	PresetRoleControlPlane = "ControlPlane"
+	PresetRoleWorkerPlane  = "WorkerPlane"
+	PresetRoleDataStore    = "Etcd"
+	PresetRoleLoadBalancer = "LoadBalancer"
+	PresetRoleManagedNodes = "ManagedNodes"
+)
+
+var builtinPresets = []Preset{
+	{
+		Name:        "dev",
+		Description: "Smallest managed cluster with a single node for development and testing",
+		ClusterType: consts.ClusterTypeMang,
+		Arch:        provider.ArchAmd64,
+		Roles: map[string]PresetRole{
+			PresetRoleManagedNodes: {Count: 1, Category: provider.GeneralPurpose, VCpus: 2, Memory: 4},
+		},
+	},
+	{
+		Name:        "ha",
+		Description: "Highly available self-managed kubeadm cluster with 3 control plane, 3 etcd and 3 worker nodes on cilium",
+		ClusterType: consts.ClusterTypeSelfMang,
+		K8sDistro:   consts.K8sKubeadm,
+		Arch:        provider.ArchAmd64,
+		Roles: map[string]PresetRole{
+			PresetRoleControlPlane: {Count: 3, Category: provider.ComputeIntensive, VCpus: 2, Memory: 4},
+			PresetRoleDataStore:    {Count: 3, Category: provider.MemoryIntensive, VCpus: 2, Memory: 8},
+			PresetRoleLoadBalancer: {Count: 1, Category: provider.GeneralPurpose, VCpus: 2, Memory: 4},
+			PresetRoleWorkerPlane:  {Count: 3, Category: provider.GeneralPurpose, VCpus: 4, Memory: 16},
+		},
+		CNI: string(consts.CNICilium),
+	},
+	{
+		Name:        "cost-optimized",
+		Description: "Self-managed k3s cluster on the cheapest small instances of any architecture with flannel",
+		ClusterType: consts.ClusterTypeSelfMang,
+		K8sDistro:   consts.K8sK3s,
+		Roles: map[string]PresetRole{
+			PresetRoleControlPlane: {Count: 3, Category: provider.GeneralPurpose, VCpus: 2, Memory: 2},
+			PresetRoleDataStore:    {Count: 3, Category: provider.GeneralPurpose, VCpus: 1, Memory: 2},
+			PresetRoleLoadBalancer: {Count: 1, Category: provider.GeneralPurpose, VCpus: 1, Memory: 1},
+			PresetRoleWorkerPlane:  {Count: 2, Category: provider.GeneralPurpose, VCpus: 2, Memory: 4},
+		},
+		CNI: string(consts.CNIFlannel),
+	},
+}
+
+func IsBuiltinPreset(name string) bool {
+	return slices.ContainsFunc(builtinPresets, func(p Preset) bool { return p.Name == name })
+}
+
+// Validate checks the preset has the roles of its cluster type
+func (p *Preset) Validate() error {
+	roles := []string{PresetRoleManagedNodes}
+	switch p.ClusterType {
+	case consts.ClusterTypeMang:
+	case consts.ClusterTypeSelfMang:
+		roles = []string{PresetRoleControlPlane, PresetRoleDataStore, PresetRoleLoadBalancer, PresetRoleWorkerPlane}
+		if len(p.K8sDistro) == 0 {
+			return fmt.Errorf("preset %s has no k8sDistro", p.Name)
+		}
+	default:
+		return fmt.Errorf("preset %s has an invalid clusterType %q", p.Name, p.ClusterType)
+	}
+
+	missing := []string{}
+	for _, r := range roles {
+		if v, ok := p.Roles[r]; !ok || v.Count <= 0 {
+			missing = append(missing, r)
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("preset %s has no count for the roles %s", p.Name, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func locatePresets() (string, error) {
+	return locateConfigDir("presets")
+}
+
+func locatePreset(name string) (string, error) {
+	if !IsValidDraftName(name) {
+		return "", fmt.Errorf("invalid preset name %q", name)
+	}
+	dir, err := locatePresets()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+// LoadPreset returns the built-in preset or the user defined one with the name
+func LoadPreset(name string, p *Preset) error {
+	for _, b := range builtinPresets {
+		if b.Name == name {
+			*p = b
+			p.Builtin = true
+			return nil
+		}
+	}
+
+	path, err := locatePreset(name)
+	if err != nil {
+		return err
+	}
+	if err := readJson(path, p); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("preset %s not found", name)
+		}
+		return fmt.Errorf("failed to read the preset %s: %v", name, err)
+	}
+	p.Name = name
+	return p.Validate()
+}
+
+// SavePreset writes the user defined preset, the built-in names are reserved
+func SavePreset(p *Preset) error {
+	if IsBuiltinPreset(p.Name) {
+		return fmt.Errorf("preset %s is built-in", p.Name)
+	}
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	path, err := locatePreset(p.Name)
+	if err != nil {
+		return err
+	}
+	return writeJson(path, p)
+}
+
+// ListPresets returns the built-in presets followed by the user defined ones, the user defined
+// presets which can't be loaded are returned with the reason instead of failing the list
+func ListPresets() ([]Preset, map[string]error, error) {
+	res := []Preset{}
+	for _, b := range builtinPresets {
+		b.Builtin = true
+		res = append(res, b)
+	}
+
+	dir, err := locatePresets()
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	malformed := map[string]error{}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if e.IsDir() || !ok || IsBuiltinPreset(name) {
+			continue
+		}
+		p := Preset{}
+		if err := LoadPreset(name, &p); err != nil {
+			malformed[name] = err
+			continue
+		}
+		res = append(res, p)
+	}
+	return res, malformed, nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	// 2025-03-03 is a monday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.March, day, hour, minute, 30, 0, time.UTC)
+	}
+
+	for _, tc := range []struct {
+		expr string
+		t    time.Time
+		want bool
+	}{
+		{"0 20 * * 1-5", at(3, 20, 0), true},
+		{"0 20 * * 1-5", at(8, 20, 0), false},
+		{"0 20 * * 1-5", at(3, 20, 1), false},
+		{"*/15 * * * *", at(3, 7, 45), true},
+		{"*/15 * * * *", at(3, 7, 46), false},
+		{"0 8,18 * * *", at(4, 18, 0), true},
+		{"0 0 * * 7", at(9, 0, 0), true},
+		{"0 0 * * 0", at(9, 0, 0), true},
+		// either the day of month or the day of week matches when both are restricted
+		{"0 0 15 * 1", at(3, 0, 0), true},
+		{"0 0 15 * 1", at(15, 0, 0), true},
+		{"0 0 15 * 1", at(4, 0, 0), false},
+		{"0 0 1 * *", at(1, 0, 0), true},
+		{"0 0 1 * *", at(2, 0, 0), false},
+	} {
+		s, err := Parse(tc.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q) = %v", tc.expr, err)
+		}
+		if got := s.Matches(tc.t); got != tc.want {
+			t.Errorf("%q.Matches(%s) = %v, want %v", tc.expr, tc.t, got, tc.want)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	s, err := Parse("0 8 * * 1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// friday evening to monday morning
+	from := time.Date(2025, time.March, 7, 20, 0, 0, 0, time.UTC)
+	want := time.Date(2025, time.March, 10, 8, 0, 0, 0, time.UTC)
+	if got := s.Next(from); !got.Equal(want) {
+		t.Errorf("Next(%s) = %s, want %s", from, got, want)
+	}
+
+	// the current minute is not returned
+	if got := s.Next(want); !got.Equal(want.AddDate(0, 0, 1)) {
+		t.Errorf("Next(%s) = %s, want %s", want, got, want.AddDate(0, 0, 1))
+	}
+
+	never, err := Parse("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := never.Next(from); !got.IsZero() {
+		t.Errorf("Next of a schedule which never fires = %s, want the zero time", got)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type info struct {
+	symbol string
+	digits int
+}
+
+// currencies maps the ISO 4217 code to its symbol and minor units,
+// codes which are not present are formatted with the code itself and 2 minor units
+var currencies = map[string]info{
+	"USD": {"$", 2},
+	"EUR": {"€", 2},
+	"GBP": {"£", 2},
+	"INR": {"₹", 2},
+	"JPY": {"¥", 0},
+	"CNY": {"CN¥", 2},
+	"KRW": {"₩", 0},
+	"AUD": {"A$", 2},
+	"CAD": {"CA$", 2},
+	"NZD": {"NZ$", 2},
+	"SGD": {"S$", 2},
+	"HKD": {"HK$", 2},
+	"CHF": {"CHF", 2},
+	"SEK": {"kr", 2},
+	"NOK": {"kr", 2},
+	"DKK": {"kr", 2},
+	"PLN": {"zł", 2},
+	"BRL": {"R$", 2},
+	"MXN": {"MX$", 2},
+	"ZAR": {"R", 2},
+	"AED": {"AED", 2},
+	"SAR": {"SAR", 2},
+	"ILS": {"₪", 2},
+	"TRY": {"₺", 2},
+	"IDR": {"Rp", 2},
+	"VND": {"₫", 0},
+	"CLP": {"CLP", 0},
+	"KWD": {"KWD", 3},
+	"BHD": {"BHD", 3},
+}
+
+type locale struct {
+	decimal     string
+	group       string
+	symbolAfter bool
+	spaced      bool
+	// indianGrouping groups the digits as 12,34,567 instead of 1,234,567
+	indianGrouping bool
+}
+
+var locales = map[string]locale{
+	"en":    {decimal: ".", group: ","},
+	"en-IN": {decimal: ".", group: ",", indianGrouping: true},
+	"hi":    {decimal: ".", group: ",", indianGrouping: true},
+	"ja":    {decimal: ".", group: ","},
+	"zh":    {decimal: ".", group: ","},
+	"ko":    {decimal: ".", group: ","},
+	"de":    {decimal: ",", group: ".", symbolAfter: true, spaced: true},
+	"de-CH": {decimal: ".", group: "’", spaced: true},
+	"fr":    {decimal: ",", group: " ", symbolAfter: true, spaced: true},
+	"es":    {decimal: ",", group: ".", symbolAfter: true, spaced: true},
+	"it":    {decimal: ",", group: ".", symbolAfter: true, spaced: true},
+	"pt":    {decimal: ",", group: ".", symbolAfter: true, spaced: true},
+	"pt-BR": {decimal: ",", group: ".", spaced: true},
+	"nl":    {decimal: ",", group: ".", spaced: true},
+	"sv":    {decimal: ",", group: " ", symbolAfter: true, spaced: true},
+	"nb":    {decimal: ",", group: " ", symbolAfter: true, spaced: true},
+	"da":    {decimal: ",", group: ".", symbolAfter: true, spaced: true},
+	"pl":    {decimal: ",", group: " ", symbolAfter: true, spaced: true},
+}
+
+const DefaultLocale = "en"
+
+// KnownCodes returns the sorted ISO codes which have a dedicated symbol
+func KnownCodes() []string {
+	codes := make([]string, 0, len(currencies))
+	for c := range currencies {
+		codes = append(codes, c)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+func IsValidCode(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseLocale converts the POSIX locale value (for ex. de_DE.UTF-8) into a language tag (for ex. de-DE)
+func ParseLocale(v string) string {
+	if i := strings.IndexAny(v, ".@"); i >= 0 {
+		v = v[:i]
+	}
+	v = strings.ReplaceAll(v, "_", "-")
+	if len(v) == 0 || v == "C" || v == "POSIX" {
+		return DefaultLocale
+	}
+	return v
+}
+
+func lookupLocale(tag string) locale {
+	if l, ok := locales[tag]; ok {
+		return l
+	}
+	if i := strings.Index(tag, "-"); i > 0 {
+		if l, ok := locales[tag[:i]]; ok {
+			return l
+		}
+	}
+	return locales[DefaultLocale]
+}
+
+func group(digits string, sep string, indian bool) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+
+	var parts []string
+	head, tail := digits[:len(digits)-3], digits[len(digits)-3:]
+	parts = append(parts, tail)
+
+	size := 3
+	if indian {
+		size = 2
+	}
+	for len(head) > size {
+		parts = append(parts, head[len(head)-size:])
+		head = head[:len(head)-size]
+	}
+	parts = append(parts, head)
+
+	for i, j := 0, len(parts)-1; i < j; i, j = i+1, j-1 {
+		parts[i], parts[j] = parts[j], parts[i]
+	}
+	return strings.Join(parts, sep)
+}
+
+// FormatIn formats the amount in the currency using the separators and symbol placement of the locale
+func FormatIn(amount float64, code string, localeTag string) string {
+	code = strings.ToUpper(code)
+	cur, ok := currencies[code]
+	if !ok {
+		cur = info{symbol: code, digits: 2}
+	}
+	loc := lookupLocale(localeTag)
+
+	neg := amount < 0
+	amount = math.Abs(amount)
+
+	num := strconv.FormatFloat(amount, 'f', cur.digits, 64)
+	intPart, fracPart, _ := strings.Cut(num, ".")
+
+	num = group(intPart, loc.group, loc.indianGrouping)
+	if cur.digits > 0 {
+		num += loc.decimal + fracPart
+	}
+
+	// symbols made of letters are always separated from the amount
+	spaced := loc.spaced || IsValidCode(cur.symbol)
+
+	sep := ""
+	if spaced {
+		sep = " "
+	}
+
+	var res string
+	if loc.symbolAfter {
+		res = num + sep + cur.symbol
+	} else {
+		res = cur.symbol + sep + num
+	}
+
+	if neg {
+		res = "-" + res
+	}
+	return res
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFormatIn(t *testing.T) {
+	for _, tc := range []struct {
+		amount float64
+		code   string
+		locale string
+		want   string
+	}{
+		{1234.5, "USD", "en", "$1,234.50"},
+		{-12.345, "usd", "en-US", "-$12.35"},
+		{1234567.891, "INR", "en-IN", "₹12,34,567.89"},
+		{1234.5, "EUR", "de-DE", "1.234,50 €"},
+		{1234.6, "JPY", "ja", "¥1,235"},
+		{1234.5, "CHF", "en", "CHF 1,234.50"},
+		{1.5, "KWD", "en", "KWD 1.500"},
+		{99, "XYZ", "en", "XYZ 99.00"},
+		{10, "USD", "unknown", "$10.00"},
+	} {
+		if got := FormatIn(tc.amount, tc.code, tc.locale); got != tc.want {
+			t.Errorf("FormatIn(%v, %s, %s) = %q, want %q", tc.amount, tc.code, tc.locale, got, tc.want)
+		}
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	for in, want := range map[string]string{
+		"de_DE.UTF-8": "de-DE",
+		"en_IN":       "en-IN",
+		"C":           "en",
+		"":            "en",
+		"fr_FR@euro":  "fr-FR",
+	} {
+		if got := ParseLocale(in); got != want {
+			t.Errorf("ParseLocale(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestConverter(t *testing.T) {
+	rates := &Rates{
+		Base:      "USD",
+		Rates:     map[string]float64{"EUR": 0.5, "INR": 80},
+		UpdatedAt: time.Now(),
+	}
+
+	t.Run("across non base currencies", func(t *testing.T) {
+		v, err := rates.Convert(10, "EUR", "INR")
+		if err != nil || math.Abs(v-1600) > 1e-9 {
+			t.Errorf("got %v, %v", v, err)
+		}
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		if _, err := rates.Convert(10, "GBP", "USD"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+
+	t.Run("falls back to the source currency", func(t *testing.T) {
+		c := &Converter{Display: "EUR", Locale: "en", Rates: rates}
+		if got := c.Format(10, "USD"); got != "€5.00" {
+			t.Errorf("got %q", got)
+		}
+		if got := c.Format(10, "GBP"); got != "£10.00" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		if rates.IsStale(time.Now(), time.Hour) {
+			t.Error("fresh rates reported as stale")
+		}
+		if !(&Rates{}).IsStale(time.Now(), time.Hour) {
+			t.Error("rates without timestamp must be stale")
+		}
+	})
+}
+
+func TestKnownCodes(t *testing.T) {
+	codes := KnownCodes()
+	if !slices.IsSorted(codes) {
+		t.Errorf("KnownCodes() = %v, want sorted", codes)
+	}
+	for _, c := range codes {
+		if !IsValidCode(c) {
+			t.Errorf("KnownCodes() has the invalid code %q", c)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Ksctl Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Rates is the exchange rates table, every rate is the amount of the currency for 1 unit of the base
+type Rates struct {
+	Base      string             `json:"base"`
+	Rates     map[string]float64 `json:"rates"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	Source    string             `json:"source,omitempty"`
+}
+
+func (r *Rates) rate(code string) (float64, bool) {
+	if strings.EqualFold(code, r.Base) {
+		return 1, true
+	}
+	v, ok := r.Rates[strings.ToUpper(code)]
+	return v, ok && v > 0
+}
+
+func (r *Rates) Validate() error {
+	if !IsValidCode(strings.ToUpper(r.Base)) {
+		return fmt.Errorf("invalid base currency %q", r.Base)
+	}
+	for code, v := range r.Rates {
+		if !IsValidCode(strings.ToUpper(code)) {
+			return fmt.Errorf("invalid currency %q", code)
+		}
+		if v <= 0 {
+			return fmt.Errorf("rate of %s must be positive", code)
+		}
+	}
+	return nil
+}
+
+func (r *Rates) Convert(amount float64, from, to string) (float64, error) {
+	if strings.EqualFold(from, to) {
+		return amount, nil
+	}
+	if r == nil {
+		return 0, fmt.Errorf("no exchange rates available")
+	}
+
+	f, ok := r.rate(from)
+	if !ok {
+		return 0, fmt.Errorf("no exchange rate for %s", from)
+	}
+	t, ok := r.rate(to)
+	if !ok {
+		return 0, fmt.Errorf("no exchange rate for %s", to)
+	}
+	return amount / f * t, nil
+}
+
+func (r *Rates) IsStale(now time.Time, maxAge time.Duration) bool {
+	return r == nil || r.UpdatedAt.IsZero() || now.Sub(r.UpdatedAt) > maxAge
+}
+
+// Converter converts the amounts into the display currency and formats them for the locale
+type Converter struct {
+	// Display is the preferred currency, when empty the amounts stay in their own currency
+	Display string
+	Locale  string
+	Rates   *Rates
+}
+
+// Convert returns the amount in the display currency,
+// when there is no rate for it the amount is returned in the source currency
+func (c *Converter) Convert(amount float64, from string) (float64, string) {
+	from = strings.ToUpper(from)
+	if len(c.Display) == 0 || len(from) == 0 {
+		return amount, from
+	}
+	v, err := c.Rates.Convert(amount, from, c.Display)
+	if err != nil {
+		return amount, from
+	}
+	return v, strings.ToUpper(c.Display)
+}
+
+// Format converts the amount into the display currency and formats it
+func (c *Converter) Format(amount float64, from string) string {
+	v, code := c.Convert(amount, from)
+	return FormatIn(v, code, c.Locale)
+}
+
+var std = &Converter{Locale: DefaultLocale}
+
+// SetDefault replaces the converter used by the package level helpers
+func SetDefault(c *Converter) {
+	std = c
+}
+
+func Default() *Converter {
+	return std
+}
+
+func Convert(amount float64, from string) (float64, string) {
+	return std.Convert(amount, from)
+}
+
+func Format(amount float64, from string) string {
+	return std.Format(amount, from)
+}